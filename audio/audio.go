@@ -19,6 +19,19 @@ type Engine struct {
 	doorOpenBuf   []byte
 	menuSelectBuf []byte
 	gameOverBuf   []byte
+	itemGetBuf    []byte
+	shieldBuf     []byte
+	jumpBuf       []byte
+	dashBuf       []byte
+	bonkBuf       []byte
+	explosionBuf  []byte
+	hookshotBuf   []byte
+	fireballBuf   []byte
+	digBuf        []byte
+	powderBuf     []byte
+	fallBuf       []byte
+	pushBuf       []byte
+	songBufs      [3][]byte
 
 	Muted  bool
 	Volume float64
@@ -31,7 +44,7 @@ func NewEngine() *Engine {
 		return &Engine{Volume: 1.0}
 	}
 
-	return &Engine{
+	e := &Engine{
 		ctx:           ctx,
 		swordSwingBuf: GenerateSwordSwing(),
 		enemyHitBuf:   GenerateEnemyHit(),
@@ -41,8 +54,24 @@ func NewEngine() *Engine {
 		doorOpenBuf:   GenerateDoorOpen(),
 		menuSelectBuf: GenerateMenuSelect(),
 		gameOverBuf:   GenerateGameOver(),
+		itemGetBuf:    GenerateItemGet(),
+		shieldBuf:     GenerateShieldBlock(),
+		jumpBuf:       GenerateJump(),
+		dashBuf:       GenerateDash(),
+		bonkBuf:       GenerateBonk(),
+		explosionBuf:  GenerateExplosion(),
+		hookshotBuf:   GenerateHookshot(),
+		fireballBuf:   GenerateFireball(),
+		digBuf:        GenerateDig(),
+		powderBuf:     GeneratePowder(),
+		fallBuf:       GenerateFall(),
+		pushBuf:       GeneratePush(),
 		Volume:        1.0,
 	}
+	for i, notes := range SongMelodies {
+		e.songBufs[i] = GenerateMelody(notes)
+	}
+	return e
 }
 
 func (e *Engine) play(buf []byte) {
@@ -76,3 +105,22 @@ func (e *Engine) PlayItemPickup() { e.play(e.itemPickupBuf) }
 func (e *Engine) PlayDoorOpen()   { e.play(e.doorOpenBuf) }
 func (e *Engine) PlayMenuSelect() { e.play(e.menuSelectBuf) }
 func (e *Engine) PlayGameOver()   { e.play(e.gameOverBuf) }
+func (e *Engine) PlayItemGet()    { e.play(e.itemGetBuf) }
+func (e *Engine) PlayShieldBlock() { e.play(e.shieldBuf) }
+func (e *Engine) PlayJump()        { e.play(e.jumpBuf) }
+func (e *Engine) PlayDash()        { e.play(e.dashBuf) }
+func (e *Engine) PlayBonk()        { e.play(e.bonkBuf) }
+func (e *Engine) PlayExplosion()   { e.play(e.explosionBuf) }
+func (e *Engine) PlayHookshot()    { e.play(e.hookshotBuf) }
+func (e *Engine) PlayFireball()    { e.play(e.fireballBuf) }
+func (e *Engine) PlayDig()         { e.play(e.digBuf) }
+func (e *Engine) PlayPowder()      { e.play(e.powderBuf) }
+func (e *Engine) PlayFall()        { e.play(e.fallBuf) }
+func (e *Engine) PlayPush()        { e.play(e.pushBuf) }
+
+// PlaySong plays an ocarina melody.
+func (e *Engine) PlaySong(song int) {
+	if song >= 0 && song < len(e.songBufs) {
+		e.play(e.songBufs[song])
+	}
+}
@@ -211,3 +211,338 @@ func GenerateGameOver() []byte {
 	}
 	return buf
 }
+
+// GenerateItemGet creates a short rising fanfare for finding an item.
+func GenerateItemGet() []byte {
+	duration := 0.6
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	// G4, A4, B4, then C5 held three times as long
+	notes := []float64{392.0, 440.0, 493.88, 523.25}
+	noteLen := samples / 6
+
+	for i := 0; i < samples; i++ {
+		noteIdx := i / noteLen
+		if noteIdx >= len(notes) {
+			noteIdx = len(notes) - 1
+		}
+		freq := notes[noteIdx]
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i) / float64(samples)
+
+		val := math.Sin(2*math.Pi*freq*t)*0.6 + math.Sin(2*math.Pi*freq*2*t)*0.25
+
+		env := 1.0 - progress*0.6
+		sample := int16(val * env * 5000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GenerateShieldBlock creates a short metallic clink.
+func GenerateShieldBlock() []byte {
+	duration := 0.08
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i) / float64(samples)
+
+		val := math.Sin(2*math.Pi*1800*t)*0.5 + math.Sin(2*math.Pi*2700*t)*0.3
+
+		env := (1.0 - progress) * (1.0 - progress)
+		sample := int16(val * env * 6000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GenerateJump creates a quick rising chirp.
+func GenerateJump() []byte {
+	duration := 0.12
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	phase := 0.0
+	for i := 0; i < samples; i++ {
+		progress := float64(i) / float64(samples)
+
+		freq := 300.0 + 500.0*progress
+		phase += 2 * math.Pi * freq / float64(sampleRate)
+		val := math.Sin(phase)
+
+		env := 1.0 - progress
+		sample := int16(val * env * 4000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GenerateDash creates a whooshing noise burst for the boots dash.
+func GenerateDash() []byte {
+	duration := 0.2
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	seed := uint32(7919)
+	for i := 0; i < samples; i++ {
+		progress := float64(i) / float64(samples)
+
+		seed ^= seed << 13
+		seed ^= seed >> 17
+		seed ^= seed << 5
+		noise := float64(int32(seed%2000)-1000) / 1000.0
+
+		env := progress * (1.0 - progress) * 4
+		sample := int16(noise * env * 3000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GenerateBonk creates a dull thud for running into a wall.
+func GenerateBonk() []byte {
+	duration := 0.15
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i) / float64(samples)
+
+		freq := 120.0 - 60.0*progress
+		val := math.Sin(2*math.Pi*freq*t)*0.8 + math.Sin(2*math.Pi*freq*3*t)*0.2
+
+		env := 1.0 - progress
+		sample := int16(val * env * 9000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GenerateExplosion creates a low rumbling noise burst.
+func GenerateExplosion() []byte {
+	duration := 0.5
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	seed := uint32(104729)
+	prev := 0.0
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i) / float64(samples)
+
+		seed ^= seed << 13
+		seed ^= seed >> 17
+		seed ^= seed << 5
+		noise := float64(int32(seed%2000)-1000) / 1000.0
+		// Low-pass the noise for a deeper boom
+		prev = prev*0.8 + noise*0.2
+
+		val := prev*0.8 + math.Sin(2*math.Pi*60*t)*0.3
+
+		env := (1.0 - progress) * (1.0 - progress)
+		sample := int16(val * env * 14000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GenerateHookshot creates a rattling run of chain clicks.
+func GenerateHookshot() []byte {
+	duration := 0.25
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i) / float64(samples)
+
+		// A click every 25 ms, each decaying quickly
+		click := math.Mod(t, 0.025) / 0.025
+		val := math.Sin(2*math.Pi*2200*t) * (1.0 - click) * (1.0 - click)
+
+		env := 1.0 - progress*0.5
+		sample := int16(val * env * 5000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GenerateFireball creates a short crackling whoosh.
+func GenerateFireball() []byte {
+	duration := 0.2
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	seed := uint32(7919)
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i) / float64(samples)
+
+		seed ^= seed << 13
+		seed ^= seed >> 17
+		seed ^= seed << 5
+		noise := float64(int32(seed%2000)-1000) / 1000.0
+
+		freq := 300.0 + 200.0*progress
+		val := noise*0.6 + math.Sin(2*math.Pi*freq*t)*0.3
+
+		env := math.Sin(math.Pi * progress)
+		sample := int16(val * env * 7000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// SongMelodies holds the ocarina melodies as note frequencies in Hz, indexed
+// by song. A zero is a rest.
+var SongMelodies = [3][]float64{
+	// Ballad of the Wind Fish
+	{523.25, 587.33, 659.25, 0, 523.25, 587.33, 659.25, 783.99, 659.25, 587.33, 523.25},
+	// Manbo's Mambo
+	{659.25, 783.99, 659.25, 587.33, 523.25, 0, 523.25, 587.33, 659.25, 523.25},
+	// Frog's Song of Soul
+	{392.00, 523.25, 493.88, 392.00, 0, 392.00, 587.33, 523.25, 392.00},
+}
+
+// SongNoteLength is how long each melody note lasts in seconds.
+const SongNoteLength = 0.18
+
+// GenerateMelody creates an ocarina-like tune: a soft, breathy sine per note.
+func GenerateMelody(notes []float64) []byte {
+	noteSamples := int(float64(sampleRate) * SongNoteLength)
+	samples := noteSamples * len(notes)
+	buf := make([]byte, samples*2)
+
+	for i := 0; i < samples; i++ {
+		freq := notes[i/noteSamples]
+		if freq == 0 {
+			continue
+		}
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i%noteSamples) / float64(noteSamples)
+
+		val := math.Sin(2*math.Pi*freq*t)*0.7 + math.Sin(2*math.Pi*freq*2*t)*0.15
+
+		// Quick attack, gentle release so notes don't click
+		env := math.Min(1.0, progress*10) * (1.0 - progress*0.6)
+		sample := int16(val * env * 8000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// SongDuration returns how long a song takes to play in seconds.
+func SongDuration(song int) float64 {
+	if song < 0 || song >= len(SongMelodies) {
+		return 0
+	}
+	return float64(len(SongMelodies[song])) * SongNoteLength
+}
+
+// GenerateDig creates a soft scrape of earth.
+func GenerateDig() []byte {
+	duration := 0.15
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	seed := uint32(4271)
+	prev := 0.0
+	for i := 0; i < samples; i++ {
+		progress := float64(i) / float64(samples)
+
+		seed ^= seed << 13
+		seed ^= seed >> 17
+		seed ^= seed << 5
+		noise := float64(int32(seed%2000)-1000) / 1000.0
+		prev = prev*0.6 + noise*0.4
+
+		env := 1.0 - progress
+		sample := int16(prev * env * 8000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GeneratePowder creates a rising sparkle of short chimes.
+func GeneratePowder() []byte {
+	duration := 0.3
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	notes := []float64{1320, 1760, 2090, 2640}
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i) / float64(samples)
+
+		note := int(progress * float64(len(notes)))
+		noteProgress := progress*float64(len(notes)) - float64(note)
+		val := math.Sin(2 * math.Pi * notes[note] * t)
+		env := (1.0 - noteProgress) * (1.0 - progress*0.5)
+		sample := int16(val * env * 4000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GenerateFall creates a long descending whistle.
+func GenerateFall() []byte {
+	duration := 0.6
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	phase := 0.0
+	for i := 0; i < samples; i++ {
+		progress := float64(i) / float64(samples)
+
+		freq := 900.0 - 700.0*progress
+		phase += 2 * math.Pi * freq / float64(sampleRate)
+		val := math.Sin(phase)
+		env := 1.0 - progress*0.8
+		sample := int16(val * env * 4500)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
+
+// GeneratePush creates the low grind of a stone block sliding.
+func GeneratePush() []byte {
+	duration := 0.2
+	samples := int(float64(sampleRate) * duration)
+	buf := make([]byte, samples*2)
+
+	seed := uint32(9137)
+	prev := 0.0
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+		progress := float64(i) / float64(samples)
+
+		seed ^= seed << 13
+		seed ^= seed >> 17
+		seed ^= seed << 5
+		noise := float64(int32(seed%2000)-1000) / 1000.0
+		prev = prev*0.85 + noise*0.15
+
+		rumble := math.Sin(2 * math.Pi * 70 * t)
+		env := 1.0 - progress*0.5
+		sample := int16((prev*0.7 + rumble*0.3) * env * 9000)
+		buf[i*2] = byte(sample)
+		buf[i*2+1] = byte(sample >> 8)
+	}
+	return buf
+}
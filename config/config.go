@@ -20,6 +20,10 @@ const (
 
 	TransitionDuration = 0.5 // seconds for screen scroll transition
 
+	// Tile physics
+	IceGrip       = 2.5  // how quickly velocity on ice catches up with input, per second
+	ConveyorSpeed = 40.0 // pixels per second a conveyor carries things
+
 	// Combat
 	SwordDuration   = 0.2
 	SwordReach      = 12
@@ -29,10 +33,80 @@ const (
 	PlayerInvTime   = 1.0
 	EnemyInvTime    = 0.5
 	ProjectileSpeed = 100.0
+	ShieldCone      = 0.5 // cos of the widest angle from facing a shield still covers
+
+	// Roc's Feather
+	JumpDuration = 0.45 // seconds airborne
+	JumpPeak     = 10.0 // pixels above the ground at the top of the arc
+	LongJumpMul  = 1.6  // airtime multiplier for a jump taken mid-dash
+
+	// Pegasus Boots
+	DashChargeTime = 0.35  // seconds the button must be held before dashing
+	DashSpeed      = 180.0 // pixels per second
+	DashRecoil     = 4.0   // pixels bounced back off a wall
+
+	// Pits and lava
+	FallTime   = 0.6 // seconds the falling animation lasts
+	PitDamage  = 1   // half a heart for falling into a pit
+	LavaDamage = 4   // two hearts for stepping into lava
+
+	// Flippers
+	DiveTime    = 1.0 // seconds spent under the surface per dive
+	DrownDamage = 2   // one heart lost for stepping into deep water
+
+	// Bombs
+	BombFuse          = 2.0  // seconds from placing to blowing
+	BombRadius        = 24.0 // blast radius in pixels
+	BombDamage        = 2
+	ExplosionDuration = 0.3
+	ThrowSpeed        = 120.0 // pixels per second for thrown objects
+	ThrowTime         = 0.4   // seconds a thrown object stays airborne
+	CarryHeight       = 12.0  // pixels a carried object sits above the player
+	CarrySpeedMul     = 0.7   // walking speed multiplier while carrying
+	ThrowDamage       = 2     // damage a thrown rock or pot deals
+	BombRefill        = 4     // bombs in a dropped refill
+
+	// Bow
+	ArrowSpeed     = 200.0
+	ArrowDamage    = 2
+	ArrowStickTime = 0.5 // seconds an arrow stays stuck in a wall
+	ArrowRefill    = 5   // arrows in a dropped refill
+
+	// Hookshot
+	HookshotSpeed = 240.0 // pixels per second, out, back and pulling
+	HookshotRange = 112.0 // pixels the chain reaches
+	HookshotStun  = 2.0   // seconds an enemy stays stunned
+
+	// Magic Rod
+	FireballSpeed  = 160.0
+	FireballDamage = 4
+	MaxFireballs   = 2 // fireballs allowed in flight at once
+
+	// Boomerang
+	BoomerangSpeed   = 150.0
+	BoomerangOutTime = 0.45 // seconds before it turns back
+	BoomerangTurn    = 10.0 // how sharply it curves home
+	BoomerangStun    = 2.5  // seconds an enemy stays stunned
+
+	// Shovel
+	HoleTime      = 8.0 // seconds before a dug hole fills back in
+	DigFindChance = 30  // percent chance a random dig turns something up
+
+	// Push blocks
+	PushTime   = 0.4  // seconds of pushing before a block gives way
+	BlockSpeed = 80.0 // pixels per second a pushed block slides, keeping ahead of the player
+
+	// Magic Powder
+	PowderRefill = 5 // sprinkles in a dropped refill
+	FairyHeal    = 6 // HP a fairy restores
+
+	// Ocarina
+	SongCalmTime = 5.0 // seconds the Song of Soul keeps enemies still
 
 	// Items
-	ItemBobSpeed  = 4.0
-	ItemBobAmount = 1
+	ItemBobSpeed   = 4.0
+	ItemBobAmount  = 1
+	BushDropChance = 25 // percent chance a cut bush drops something
 
 	// Dialogue
 	DialogueBoxH  = 48
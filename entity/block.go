@@ -0,0 +1,43 @@
+package entity
+
+import "github.com/AchrafSoltani/GlowQuest/config"
+
+// PushBlock is a stone block sliding one tile after the player pushed it.
+// It becomes a block tile again once it arrives.
+type PushBlock struct {
+	X, Y    float64
+	Dir     Direction
+	ToX     int // destination tile
+	ToY     int
+	Left    float64 // pixels still to slide
+	Arrived bool
+}
+
+// NewPushBlock starts a block sliding from tile (tx, ty) in a direction.
+func NewPushBlock(tx, ty int, dir Direction) *PushBlock {
+	return &PushBlock{
+		X:    float64(tx * config.TileSize),
+		Y:    float64(ty * config.TileSize),
+		Dir:  dir,
+		ToX:  tx + int(dir.DX()),
+		ToY:  ty + int(dir.DY()),
+		Left: float64(config.TileSize),
+	}
+}
+
+// Update slides the block towards its destination. Returns true on the
+// frame it arrives.
+func (b *PushBlock) Update(dt float64) bool {
+	if b.Arrived {
+		return false
+	}
+	step := config.BlockSpeed * dt
+	if step >= b.Left {
+		step = b.Left
+		b.Arrived = true
+	}
+	b.Left -= step
+	b.X += b.Dir.DX() * step
+	b.Y += b.Dir.DY() * step
+	return b.Arrived
+}
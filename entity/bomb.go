@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/AchrafSoltani/GlowQuest/config"
+)
+
+// Bomb is a placed bomb with a burning fuse. It can be picked up and thrown.
+type Bomb struct {
+	ThrowArc
+	X, Y          float64
+	Width, Height int
+	Fuse          float64 // seconds until it blows
+	Carried       bool
+}
+
+func NewBomb(x, y float64) *Bomb {
+	return &Bomb{
+		X:      x,
+		Y:      y,
+		Width:  12,
+		Height: 12,
+		Fuse:   config.BombFuse,
+	}
+}
+
+// Update burns the fuse and advances the throw arc. Returns true when the
+// bomb blows.
+func (b *Bomb) Update(dt float64) bool {
+	b.Fuse -= dt
+	b.UpdateArc(dt)
+	return b.Fuse <= 0
+}
+
+// Throw releases a carried bomb in a direction.
+func (b *Bomb) Throw(dir Direction) {
+	b.Carried = false
+	b.ThrowArc.Throw(dir)
+}
+
+// FuseFlash returns true on frames where the bomb flashes; it flashes faster
+// as the fuse runs down.
+func (b *Bomb) FuseFlash() bool {
+	rate := 4.0
+	if b.Fuse < config.BombFuse/2 {
+		rate = 12.0
+	}
+	return math.Mod(b.Fuse*rate, 1) < 0.5
+}
+
+func (b *Bomb) CenterX() float64 { return b.X + float64(b.Width)/2 }
+func (b *Bomb) CenterY() float64 { return b.Y + float64(b.Height)/2 }
+
+// Explosion is the short-lived blast left when a bomb goes off.
+type Explosion struct {
+	X, Y  float64 // centre
+	Timer float64
+}
+
+func (e *Explosion) Update(dt float64) {
+	e.Timer += dt
+}
+
+func (e *Explosion) Done() bool {
+	return e.Timer >= config.ExplosionDuration
+}
+
+// Progress returns 0→1 through the blast.
+func (e *Explosion) Progress() float64 {
+	p := e.Timer / config.ExplosionDuration
+	if p > 1 {
+		return 1
+	}
+	return p
+}
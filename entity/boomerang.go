@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/AchrafSoltani/GlowQuest/config"
+)
+
+// Boomerang flies out in a straight line, then curves back to the thrower,
+// carrying any item it picked up on the way.
+type Boomerang struct {
+	X, Y          float64
+	Width, Height int
+	VX, VY        float64
+	OutTimer      float64 // seconds of outbound flight left
+	Returning     bool
+	Spin          float64
+	Item          *Item // item being brought back
+}
+
+func NewBoomerang(x, y float64, dir Direction) *Boomerang {
+	return &Boomerang{
+		X:        x,
+		Y:        y,
+		Width:    8,
+		Height:   8,
+		VX:       dir.DX() * config.BoomerangSpeed,
+		VY:       dir.DY() * config.BoomerangSpeed,
+		OutTimer: config.BoomerangOutTime,
+	}
+}
+
+// Return turns the boomerang around early.
+func (b *Boomerang) Return() {
+	b.Returning = true
+	b.OutTimer = 0
+}
+
+// Update moves the boomerang. On the way back it steers towards (tx, ty),
+// swinging round in a curve rather than reversing on the spot.
+func (b *Boomerang) Update(dt, tx, ty float64) {
+	b.Spin += dt
+	if !b.Returning {
+		b.OutTimer -= dt
+		if b.OutTimer <= 0 {
+			b.Return()
+		}
+	}
+
+	if b.Returning {
+		dx := tx - b.CenterX()
+		dy := ty - b.CenterY()
+		if dist := math.Sqrt(dx*dx + dy*dy); dist > 0 {
+			want := config.BoomerangSpeed / dist
+			turn := math.Min(1, config.BoomerangTurn*dt)
+			b.VX += (dx*want - b.VX) * turn
+			b.VY += (dy*want - b.VY) * turn
+			if speed := math.Sqrt(b.VX*b.VX + b.VY*b.VY); speed > 0 {
+				b.VX *= config.BoomerangSpeed / speed
+				b.VY *= config.BoomerangSpeed / speed
+			}
+		}
+	}
+
+	b.X += b.VX * dt
+	b.Y += b.VY * dt
+	if b.Item != nil {
+		b.Item.X = b.CenterX() - float64(b.Item.Width)/2
+		b.Item.Y = b.CenterY() - float64(b.Item.Height)/2
+	}
+}
+
+// Frame returns the spin frame (0-3).
+func (b *Boomerang) Frame() int {
+	return int(b.Spin*20) % 4
+}
+
+func (b *Boomerang) CenterX() float64 { return b.X + float64(b.Width)/2 }
+func (b *Boomerang) CenterY() float64 { return b.Y + float64(b.Height)/2 }
@@ -24,6 +24,8 @@ const (
 	EnemyZora      // 14
 	EnemyArmos     // 15
 	EnemyLanmola   // 16
+
+	EnemyIceOctorok // 17 (fire kills it outright)
 )
 
 type Enemy struct {
@@ -43,6 +45,8 @@ type Enemy struct {
 	WalkFrame       int
 	WalkTimer       float64
 	ShootTimer      float64
+	StunTimer       float64 // seconds left frozen by the hookshot
+	VelX, VelY      float64 // walking velocity, kept as momentum on ice
 	// Boss-specific
 	AIState    int
 	ChargeX    float64
@@ -65,6 +69,21 @@ func NewOctorok(x, y float64) *Enemy {
 	}
 }
 
+func NewIceOctorok(x, y float64) *Enemy {
+	return &Enemy{
+		Type:   EnemyIceOctorok,
+		X:      x,
+		Y:      y,
+		Width:  14,
+		Height: 14,
+		Dir:    DirDown,
+		Speed:  25,
+		HP:     6,
+		MaxHP:  6,
+		ShootTimer: 2.5,
+	}
+}
+
 func NewMoblin(x, y float64) *Enemy {
 	return &Enemy{
 		Type:   EnemyMoblin,
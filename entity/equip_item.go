@@ -21,6 +21,30 @@ const (
 	EquipMagicPowder   EquipItemID = 14
 )
 
+// equipItemKeys maps data-file item keys (chest contents) to items.
+var equipItemKeys = map[string]EquipItemID{
+	"sword":          EquipSword,
+	"shield":         EquipShield,
+	"bow":            EquipBow,
+	"bombs":          EquipBomb,
+	"rocs_feather":   EquipRocsFeather,
+	"pegasus_boots":  EquipPegasusBoots,
+	"power_bracelet": EquipPowerBracelet,
+	"flippers":       EquipFlippers,
+	"hookshot":       EquipHookshot,
+	"magic_rod":      EquipMagicRod,
+	"boomerang":      EquipBoomerang,
+	"ocarina":        EquipOcarina,
+	"shovel":         EquipShovel,
+	"magic_powder":   EquipMagicPowder,
+}
+
+// EquipItemByKey returns the item for a data-file key such as "bow",
+// or EquipNone if the key is not an equippable item.
+func EquipItemByKey(key string) EquipItemID {
+	return equipItemKeys[key]
+}
+
 // EquipItemName returns a display name for the item.
 func EquipItemName(id EquipItemID) string {
 	switch id {
@@ -0,0 +1,37 @@
+package entity
+
+// HookState is what the hookshot is doing.
+type HookState int
+
+const (
+	HookExtending  HookState = iota // chain flying out
+	HookRetracting                  // chain reeling back in
+	HookPulling                     // latched on, pulling the player over
+)
+
+// Hookshot is a fired chain. It runs from the player's centre to its tip
+// and drags back whatever it caught.
+type Hookshot struct {
+	Dir              Direction
+	State            HookState
+	OriginX, OriginY float64 // where the chain leaves the player
+	TipX, TipY       float64
+	Item             *Item  // item being dragged back
+	Enemy            *Enemy // enemy being pulled in
+}
+
+func NewHookshot(p *Player) *Hookshot {
+	return &Hookshot{
+		Dir:     p.Dir,
+		State:   HookExtending,
+		OriginX: p.CenterX(),
+		OriginY: p.CenterY(),
+		TipX:    p.CenterX(),
+		TipY:    p.CenterY(),
+	}
+}
+
+// Length returns how far the tip is from the player.
+func (h *Hookshot) Length() float64 {
+	return (h.TipX-h.OriginX)*h.Dir.DX() + (h.TipY-h.OriginY)*h.Dir.DY()
+}
@@ -9,6 +9,8 @@ type Inventory struct {
 	BombsMax  int
 	Arrows    int
 	ArrowsMax int
+	Powder    int
+	PowderMax int
 
 	// Equipment
 	OwnedItems    map[EquipItemID]bool
@@ -31,6 +33,7 @@ func NewInventory() Inventory {
 		OwnedItems: make(map[EquipItemID]bool),
 		BombsMax:   30,
 		ArrowsMax:  30,
+		PowderMax:  20,
 	}
 }
 
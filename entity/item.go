@@ -10,6 +10,11 @@ const (
 	ItemKey
 	ItemSword
 	ItemHeartContainer
+	ItemArrows
+	ItemBombs
+	ItemSeashell
+	ItemPowder
+	ItemFairy
 )
 
 type Item struct {
@@ -18,6 +23,8 @@ type Item struct {
 	Width, Height int
 	Collected     bool
 	BobTimer      float64
+	SaveKey       string // CollectedItems key; empty uses the screen index
+	Hidden        bool   // still under the tile covering it
 }
 
 func NewItem(typ ItemType, x, y float64) *Item {
@@ -5,6 +5,7 @@ package entity
 type DialogueOption struct {
 	Condition string
 	Lines     []string
+	SetFlag   string // quest flag set when these lines are shown
 }
 
 type NPC struct {
@@ -15,6 +16,8 @@ type NPC struct {
 	Name          string
 	Dialogue      []string         // default/fallback dialogue
 	Dialogues     []DialogueOption // conditional dialogues (checked first)
+	Asleep        bool             // only snores until woken by the Song of Soul
+	PowderFlag    string           // quest flag set when sprinkled with Magic Powder
 }
 
 func NewNPC(id string, x, y float64, dir Direction, name string, dialogue []string, dialogues []DialogueOption) *NPC {
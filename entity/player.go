@@ -21,15 +21,35 @@ type Player struct {
 	Jumping    bool
 	JumpTimer  float64
 	JumpHeight float64
+	JumpStartX float64 // where the jump took off, for bad landings
+	JumpStartY float64
 	Dashing    bool
 	DashTimer  float64
 	DashDir    Direction
+	DashCharging bool // boots held, building up to a dash
+	LongJump   bool   // jump taken mid-dash: stays airborne longer
+	LedgeHop   bool   // hopping down a cliff ledge
+	HopDir     Direction
 	Swimming   bool
+	Diving     bool    // under the surface while swimming
+	DiveTimer  float64
+	SafeX      float64 // last spot on dry ground, for drowning
+	SafeY      float64
+	Falling    bool    // dropping into a pit or lava
+	FallTimer  float64
 	Lifting    bool
 	Pushing    bool
 	PushTimer  float64
 	UsingItem  bool
 	ItemUseTimer float64
+	ItemGet    bool // holding a found item overhead
+	Shielding  bool // shield raised in Dir
+
+	// Tile physics
+	VelX, VelY     float64 // walking velocity, kept as momentum on ice
+	KnockbackX     float64
+	KnockbackY     float64
+	KnockbackTimer float64
 }
 
 func NewPlayer(x, y float64) *Player {
@@ -46,6 +66,107 @@ func NewPlayer(x, y float64) *Player {
 	}
 }
 
+// StartJump launches the player into a Roc's Feather jump.
+func (p *Player) StartJump() {
+	p.Jumping = true
+	p.JumpTimer = 0
+	p.JumpHeight = 0
+	p.JumpStartX = p.X
+	p.JumpStartY = p.Y
+	p.LongJump = p.Dashing
+}
+
+// StartLedgeHop hops the player down a ledge in the direction they face.
+func (p *Player) StartLedgeHop() {
+	p.StartJump()
+	p.LedgeHop = true
+	p.HopDir = p.Dir
+	p.StopDash()
+}
+
+// UpdateJump advances the jump arc. Returns true on the frame the player lands.
+func (p *Player) UpdateJump(dt float64) bool {
+	if !p.Jumping {
+		return false
+	}
+	p.JumpTimer += dt
+	duration := config.JumpDuration
+	if p.LongJump {
+		duration *= config.LongJumpMul
+	}
+	t := p.JumpTimer / duration
+	if t >= 1 {
+		p.Jumping = false
+		p.LedgeHop = false
+		p.JumpTimer = 0
+		p.JumpHeight = 0
+		return true
+	}
+	p.JumpHeight = config.JumpPeak * 4 * t * (1 - t)
+	return false
+}
+
+// StartDive takes a swimming player under the surface.
+func (p *Player) StartDive() {
+	p.Diving = true
+	p.DiveTimer = config.DiveTime
+}
+
+// UpdateDive counts down a dive and surfaces the player when it ends.
+func (p *Player) UpdateDive(dt float64) {
+	if !p.Diving {
+		return
+	}
+	p.DiveTimer -= dt
+	if p.DiveTimer <= 0 || !p.Swimming {
+		p.Diving = false
+		p.DiveTimer = 0
+	}
+}
+
+// StartFall drops the player into the pit at grid (tx, ty).
+func (p *Player) StartFall(tx, ty int) {
+	ts := config.TileSize
+	p.X = float64(tx*ts + (ts-p.Width)/2)
+	p.Y = float64(ty*ts + (ts-p.Height)/2)
+	p.Falling = true
+	p.FallTimer = 0
+	p.Moving = false
+	p.VelX, p.VelY = 0, 0
+	p.KnockbackTimer = 0
+	p.StopDash()
+}
+
+// StartDashCharge begins charging a Pegasus Boots dash.
+func (p *Player) StartDashCharge() {
+	p.DashCharging = true
+	p.DashTimer = 0
+}
+
+// ChargeDash builds up the dash charge. Returns true when the dash begins,
+// heading the way the player faces.
+func (p *Player) ChargeDash(dt float64) bool {
+	if !p.DashCharging {
+		return false
+	}
+	p.DashTimer += dt
+	if p.DashTimer < config.DashChargeTime {
+		return false
+	}
+	p.DashCharging = false
+	p.Dashing = true
+	p.DashTimer = 0
+	p.DashDir = p.Dir
+	return true
+}
+
+// StopDash ends a dash or cancels its charge.
+func (p *Player) StopDash() {
+	p.Dashing = false
+	p.DashCharging = false
+	p.DashTimer = 0
+}
+
 func (p *Player) CenterX() float64 { return p.X + float64(p.Width)/2 }
 func (p *Player) CenterY() float64 { return p.Y + float64(p.Height)/2 }
 
@@ -2,7 +2,18 @@ package entity
 
 import "github.com/AchrafSoltani/GlowQuest/config"
 
+// ProjectileKind identifies what a projectile is.
+type ProjectileKind int
+
+const (
+	ProjectileOrb       ProjectileKind = iota // enemy shot
+	ProjectileArrow                           // player arrow
+	ProjectileBombArrow                       // arrow carrying a bomb; explodes on impact
+	ProjectileFireball                        // Magic Rod fire; lights torches and burns bushes
+)
+
 type Projectile struct {
+	Kind          ProjectileKind
 	X, Y          float64
 	DirX, DirY    float64
 	Speed         float64
@@ -10,6 +21,9 @@ type Projectile struct {
 	FromEnemy     bool
 	Width, Height int
 	Dead          bool
+	Strong        bool    // only the mirror shield can stop it
+	Stuck         bool    // arrow lodged in a wall
+	StuckTimer    float64 // seconds until a stuck arrow disappears
 }
 
 func NewEnemyProjectile(x, y, dirX, dirY float64) *Projectile {
@@ -26,7 +40,67 @@ func NewEnemyProjectile(x, y, dirX, dirY float64) *Projectile {
 	}
 }
 
+// NewArrow creates a player arrow flying in a direction.
+func NewArrow(x, y float64, dir Direction, bomb bool) *Projectile {
+	kind := ProjectileArrow
+	if bomb {
+		kind = ProjectileBombArrow
+	}
+	w, h := 8, 3
+	if dir == DirUp || dir == DirDown {
+		w, h = 3, 8
+	}
+	return &Projectile{
+		Kind:   kind,
+		X:      x - float64(w)/2,
+		Y:      y - float64(h)/2,
+		DirX:   dir.DX(),
+		DirY:   dir.DY(),
+		Speed:  config.ArrowSpeed,
+		Damage: config.ArrowDamage,
+		Width:  w,
+		Height: h,
+	}
+}
+
+// NewFireball creates a Magic Rod fireball flying in a direction.
+func NewFireball(x, y float64, dir Direction) *Projectile {
+	return &Projectile{
+		Kind:   ProjectileFireball,
+		X:      x - 3,
+		Y:      y - 3,
+		DirX:   dir.DX(),
+		DirY:   dir.DY(),
+		Speed:  config.FireballSpeed,
+		Damage: config.FireballDamage,
+		Width:  6,
+		Height: 6,
+	}
+}
+
+// IsArrow returns true for player arrows of either kind.
+func (p *Projectile) IsArrow() bool {
+	return p.Kind == ProjectileArrow || p.Kind == ProjectileBombArrow
+}
+
+// Stick lodges an arrow where it is for a moment.
+func (p *Projectile) Stick() {
+	p.Stuck = true
+	p.StuckTimer = config.ArrowStickTime
+}
+
+func (p *Projectile) CenterX() float64 { return p.X + float64(p.Width)/2 }
+func (p *Projectile) CenterY() float64 { return p.Y + float64(p.Height)/2 }
+
 func (p *Projectile) Update(dt float64) {
+	if p.Stuck {
+		p.StuckTimer -= dt
+		if p.StuckTimer <= 0 {
+			p.Dead = true
+		}
+		return
+	}
+
 	p.X += p.DirX * p.Speed * dt
 	p.Y += p.DirY * p.Speed * dt
 
@@ -0,0 +1,24 @@
+package entity
+
+// SongID identifies an ocarina song. It indexes Inventory.Songs.
+type SongID int
+
+const (
+	SongBallad SongID = iota // Ballad of the Wind Fish: opens sealed song doors
+	SongMambo                // Manbo's Mambo: warps to an activated warp point
+	SongSoul                 // Frog's Song of Soul: wakes sleepers, calms enemies
+)
+
+var songNames = [3]string{
+	"Ballad of the Wind Fish",
+	"Manbo's Mambo",
+	"Frog's Song of Soul",
+}
+
+// SongName returns the display name of a song.
+func SongName(id SongID) string {
+	if id < 0 || int(id) >= len(songNames) {
+		return ""
+	}
+	return songNames[id]
+}
@@ -7,10 +7,12 @@ type SwordSwing struct {
 	Timer    float64
 	Duration float64
 	Dir      Direction
+	Jumping  bool // swung in mid-air: a jumping attack
 }
 
 func (s *SwordSwing) Start(dir Direction) {
 	s.Active = true
+	s.Jumping = false
 	s.Timer = 0
 	s.Duration = config.SwordDuration
 	s.Dir = dir
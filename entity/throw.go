@@ -0,0 +1,86 @@
+package entity
+
+import "github.com/AchrafSoltani/GlowQuest/config"
+
+// ThrowArc is the flight of something the player threw: it travels in a
+// straight line while dropping from carry height to the ground.
+type ThrowArc struct {
+	VX, VY   float64 // throw velocity
+	AirTimer float64 // seconds left in the throw
+	Lift     float64 // pixels above the ground
+}
+
+// Throw starts an arc from carry height in a direction.
+func (a *ThrowArc) Throw(dir Direction) {
+	a.VX = dir.DX() * config.ThrowSpeed
+	a.VY = dir.DY() * config.ThrowSpeed
+	a.AirTimer = config.ThrowTime
+	a.Lift = config.CarryHeight
+}
+
+// Airborne returns true while the throw is in flight.
+func (a *ThrowArc) Airborne() bool {
+	return a.AirTimer > 0
+}
+
+// UpdateArc lowers the object along the arc. Returns true on the frame it
+// lands.
+func (a *ThrowArc) UpdateArc(dt float64) bool {
+	if a.AirTimer <= 0 {
+		return false
+	}
+	a.AirTimer -= dt
+	landed := false
+	if a.AirTimer <= 0 {
+		a.AirTimer = 0
+		a.VX = 0
+		a.VY = 0
+		landed = true
+	}
+	t := 1 - a.AirTimer/config.ThrowTime
+	a.Lift = config.CarryHeight * (1 - t*t)
+	return landed
+}
+
+// LiftedKind identifies an object picked up with the Power Bracelet.
+type LiftedKind int
+
+const (
+	LiftedRock LiftedKind = iota
+	LiftedHeavyRock
+	LiftedPot
+)
+
+// Lifted is a rock or pot pulled out of the ground. It is carried overhead,
+// then thrown and shatters where it lands or on whatever it hits.
+type Lifted struct {
+	ThrowArc
+	Kind          LiftedKind
+	X, Y          float64
+	Width, Height int
+	Carried       bool
+	Dead          bool
+}
+
+func NewLifted(kind LiftedKind, x, y float64) *Lifted {
+	return &Lifted{
+		Kind:    kind,
+		X:       x,
+		Y:       y,
+		Width:   14,
+		Height:  14,
+		Carried: true,
+		ThrowArc: ThrowArc{
+			Lift: config.CarryHeight,
+		},
+	}
+}
+
+// Throw releases the object in a direction.
+func (l *Lifted) Throw(dir Direction) {
+	l.Carried = false
+	l.ThrowArc.Throw(dir)
+}
+
+func (l *Lifted) CenterX() float64 { return l.X + float64(l.Width)/2 }
+func (l *Lifted) CenterY() float64 { return l.Y + float64(l.Height)/2 }
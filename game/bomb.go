@@ -0,0 +1,232 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// placeBomb sets a lit bomb down in front of the player, or at their feet if
+// that spot is solid.
+func (g *Game) placeBomb() {
+	p := g.Player
+	b := entity.NewBomb(p.CenterX()-6+p.Dir.DX()*config.TileSize, p.CenterY()-6+p.Dir.DY()*config.TileSize)
+	if system.TileCollision(g.currentScreen(), b.X, b.Y, b.Width, b.Height) {
+		b.X = p.CenterX() - 6
+		b.Y = p.CenterY() - 6
+	}
+	p.Inventory.Bombs--
+	g.Bombs = append(g.Bombs, b)
+	g.Audio.PlayMenuSelect()
+}
+
+// tryLiftBomb picks up a lit bomb next to the player.
+func (g *Game) tryLiftBomb() bool {
+	if g.Player.Lifting {
+		return false
+	}
+	for _, b := range g.Bombs {
+		if b.AirTimer > 0 {
+			continue
+		}
+		if system.ProximityCheck(g.Player.CenterX(), g.Player.CenterY(),
+			b.CenterX(), b.CenterY(), config.InteractRadius) {
+			b.Carried = true
+			b.Lift = config.CarryHeight
+			g.CarriedBomb = b
+			g.Player.Lifting = true
+			return true
+		}
+	}
+	return false
+}
+
+// throwCarried throws whatever the player is carrying in the facing direction.
+func (g *Game) throwCarried() bool {
+	switch {
+	case g.CarriedBomb != nil:
+		g.CarriedBomb.Throw(g.Player.Dir)
+		g.CarriedBomb = nil
+	case g.CarriedObject != nil:
+		g.CarriedObject.Throw(g.Player.Dir)
+		g.CarriedObject = nil
+	default:
+		return false
+	}
+	g.Player.Lifting = false
+	g.Audio.PlaySwordSwing()
+	return true
+}
+
+// updateBombs burns fuses, moves thrown bombs and blows the ones whose fuse
+// ran out. Returns true if a blast ended the game.
+func (g *Game) updateBombs(dt float64) bool {
+	screen := g.currentScreen()
+	alive := g.Bombs[:0]
+	var blown []*entity.Bomb
+	for _, b := range g.Bombs {
+		if b.Carried {
+			b.X = g.Player.CenterX() - float64(b.Width)/2
+			b.Y = g.Player.CenterY() - float64(b.Height)/2
+		} else if b.AirTimer > 0 {
+			nx := b.X + b.VX*dt
+			ny := b.Y + b.VY*dt
+			if system.TileCollision(screen, nx, ny, b.Width, b.Height) ||
+				nx < 0 || ny < 0 || nx+float64(b.Width) > config.PlayAreaWidth ||
+				ny+float64(b.Height) > config.PlayAreaHeight {
+				b.VX = 0
+				b.VY = 0
+			} else {
+				b.X = nx
+				b.Y = ny
+			}
+		}
+		if b.Update(dt) {
+			blown = append(blown, b)
+			continue
+		}
+		alive = append(alive, b)
+	}
+	g.Bombs = alive
+
+	for _, b := range blown {
+		if b == g.CarriedBomb {
+			g.CarriedBomb = nil
+			g.Player.Lifting = false
+		}
+		if g.explode(b.CenterX(), b.CenterY()) {
+			return true
+		}
+	}
+
+	liveExp := g.Explosions[:0]
+	for _, e := range g.Explosions {
+		e.Update(dt)
+		if !e.Done() {
+			liveExp = append(liveExp, e)
+		}
+	}
+	g.Explosions = liveExp
+	return false
+}
+
+// explode sets off a blast: it hurts enemies and the player in range and
+// breaks bombable walls. Returns true if it ended the game.
+func (g *Game) explode(x, y float64) bool {
+	g.Explosions = append(g.Explosions, &entity.Explosion{X: x, Y: y})
+	g.ShakeTimer = config.ShakeDuration
+	g.Audio.PlayExplosion()
+
+	r := config.BombRadius
+	for _, e := range g.Enemies {
+		if e.Dead || e.InvTimer > 0 {
+			continue
+		}
+		if system.ProximityCheck(x, y, e.CenterX(), e.CenterY(), r) {
+			if g.hitEnemy(e, config.BombDamage, x, y) {
+				return true
+			}
+		}
+	}
+
+	g.blastTiles(x, y)
+
+	if g.Player.InvTimer <= 0 &&
+		system.ProximityCheck(x, y, g.Player.CenterX(), g.Player.CenterY(), r) {
+		g.damagePlayer(config.BombDamage)
+	}
+	return false
+}
+
+// blastTiles breaks every bombable tile within the blast. Bombable dungeon
+// doors open on both sides; other walls are remembered in BombedWalls.
+func (g *Game) blastTiles(x, y float64) {
+	screen := g.currentScreen()
+	ts := config.TileSize
+	reach := int(config.BombRadius)/ts + 1
+	cx, cy := int(x)/ts, int(y)/ts
+
+	for ty := cy - reach; ty <= cy+reach; ty++ {
+		for tx := cx - reach; tx <= cx+reach; tx++ {
+			if tx < 0 || tx >= config.ScreenGridW || ty < 0 || ty >= config.ScreenGridH {
+				continue
+			}
+			if !world.TileProps[screen.Tiles[ty][tx]].Bombable {
+				continue
+			}
+			// Nearest point of the tile to the blast centre
+			nx := clampf(x, float64(tx*ts), float64(tx*ts+ts))
+			ny := clampf(y, float64(ty*ts), float64(ty*ts+ts))
+			if !system.ProximityCheck(x, y, nx, ny, config.BombRadius) {
+				continue
+			}
+			if g.blastDungeonDoor(tx, ty) {
+				continue
+			}
+			screen.Tiles[ty][tx] = g.bombedTile(screen.Tiles[ty][tx])
+			g.BombedWalls[fmt.Sprintf("%s_%d,%d", g.screenKey(), tx, ty)] = true
+			g.spawnTileParticles(tx, ty, 140, 120, 100)
+		}
+	}
+}
+
+// blastDungeonDoor opens a bombable dungeon door if (tx, ty) is part of it.
+func (g *Game) blastDungeonDoor(tx, ty int) bool {
+	if g.Location != LocationDungeon || g.CurrentDungeon == nil {
+		return false
+	}
+	d := g.CurrentDungeon
+	room := d.CurrentDungeonRoom()
+	side := world.SideAt(tx, ty)
+	if room == nil || side < 0 || room.Doors[side] == nil ||
+		room.Doors[side].Type != world.DoorBombable || room.Doors[side].Opened {
+		return false
+	}
+	d.OpenDoor(room.X, room.Y, side)
+	g.Audio.PlayDoorOpen()
+	g.SaveGame()
+	return true
+}
+
+// bombedTile returns what a bombable tile becomes once blown open.
+func (g *Game) bombedTile(t world.TileType) world.TileType {
+	if t == world.TileCrackedWall {
+		return world.TileDoorOpen
+	}
+	if g.Location == LocationOverworld {
+		return world.TileGrass
+	}
+	return world.TileFloor
+}
+
+// applyBombedWalls re-opens the walls blown on the current screen.
+func (g *Game) applyBombedWalls(screen *world.Screen) {
+	prefix := g.screenKey() + "_"
+	for key := range g.BombedWalls {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		var tx, ty int
+		if _, err := fmt.Sscanf(key[len(prefix):], "%d,%d", &tx, &ty); err != nil {
+			continue
+		}
+		if tx >= 0 && tx < config.ScreenGridW && ty >= 0 && ty < config.ScreenGridH &&
+			world.TileProps[screen.Tiles[ty][tx]].Bombable {
+			screen.Tiles[ty][tx] = g.bombedTile(screen.Tiles[ty][tx])
+		}
+	}
+}
+
+func clampf(v, lo, hi float64) float64 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
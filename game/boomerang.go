@@ -0,0 +1,92 @@
+package game
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// throwBoomerang sends the boomerang out in the facing direction. Only one
+// can be in flight.
+func (g *Game) throwBoomerang() {
+	if g.Boomerang != nil {
+		return
+	}
+	p := g.Player
+	g.Boomerang = entity.NewBoomerang(p.CenterX()-4, p.CenterY()-4, p.Dir)
+	g.Audio.PlaySwordSwing()
+}
+
+// updateBoomerang flies the boomerang and applies what it touches. Returns
+// true if a hit ended the game.
+func (g *Game) updateBoomerang(dt float64) bool {
+	b := g.Boomerang
+	if b == nil {
+		return false
+	}
+	p := g.Player
+	b.Update(dt, p.CenterX(), p.CenterY())
+
+	// Caught
+	if b.Returning && system.ProximityCheck(b.CenterX(), b.CenterY(), p.CenterX(), p.CenterY(), 8) {
+		if b.Item != nil {
+			b.Item.X = p.CenterX() - float64(b.Item.Width)/2
+			b.Item.Y = p.CenterY() - float64(b.Item.Height)/2
+		}
+		g.Boomerang = nil
+		return false
+	}
+
+	// Walls turn it back on the way out; coming home it passes over them
+	screen := g.currentScreen()
+	if !b.Returning && (system.TileCollision(screen, b.X, b.Y, b.Width, b.Height) ||
+		b.X < 0 || b.Y < 0 || b.X+float64(b.Width) > config.PlayAreaWidth ||
+		b.Y+float64(b.Height) > config.PlayAreaHeight) {
+		b.Return()
+		g.Audio.PlayShieldBlock()
+	}
+
+	tx := int(b.CenterX()) / config.TileSize
+	ty := int(b.CenterY()) / config.TileSize
+	if screen.TileAt(tx, ty) == world.TileSwitchOff {
+		g.pressSwitch(screen, tx, ty)
+	}
+
+	bx, by, bw, bh := b.X, b.Y, float64(b.Width), float64(b.Height)
+	for _, e := range g.Enemies {
+		if e.Dead || e.InvTimer > 0 {
+			continue
+		}
+		if !system.AABBOverlap(bx, by, bw, bh, e.X, e.Y, float64(e.Width), float64(e.Height)) {
+			continue
+		}
+		b.Return()
+		switch {
+		case e.Type == entity.EnemyBoss:
+			g.Audio.PlayShieldBlock()
+		case e.MaxHP <= 1:
+			// Weak enemies go down to a single hit
+			if g.hitEnemy(e, 1, b.CenterX(), b.CenterY()) {
+				return true
+			}
+		case e.StunTimer <= 0:
+			e.StunTimer = config.BoomerangStun
+			g.Audio.PlayEnemyHit()
+		}
+	}
+
+	if b.Item == nil {
+		for _, item := range g.Items {
+			if item.Collected || item.Hidden {
+				continue
+			}
+			if system.AABBOverlap(bx, by, bw, bh, item.X, item.Y, float64(item.Width), float64(item.Height)) {
+				b.Item = item
+				b.Return()
+				break
+			}
+		}
+	}
+	return false
+}
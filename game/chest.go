@@ -0,0 +1,148 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// tryOpenChest opens the dungeon chest the player is facing, awards its
+// contents and shows the item-get pose and message.
+func (g *Game) tryOpenChest() bool {
+	if g.Location != LocationDungeon || g.CurrentDungeon == nil {
+		return false
+	}
+	d := g.CurrentDungeon
+	room := d.CurrentDungeonRoom()
+	if room == nil {
+		return false
+	}
+
+	px := int(g.Player.CenterX()) / config.TileSize
+	py := int(g.Player.CenterY()) / config.TileSize
+	tx := px + int(g.Player.Dir.DX())
+	ty := py + int(g.Player.Dir.DY())
+	if room.Screen.TileAt(tx, ty) != world.TileChest {
+		return false
+	}
+
+	for i := range room.Chests {
+		c := &room.Chests[i]
+		if c.X != tx || c.Y != ty || c.Opened {
+			continue
+		}
+		c.Opened = true
+		d.OpenedChests[c.Flag] = true
+		room.Screen.Tiles[ty][tx] = world.TileChestOpen
+
+		lines := g.awardChestContents(c.Contents, c.Flag)
+		g.Player.ItemGet = true
+		g.Player.Dir = entity.DirDown
+		g.HeldItem = c.Contents
+		g.Dialogue.StartMessage("", lines)
+		g.State = StateDialogue
+		g.Audio.PlayItemGet()
+		g.SaveGame()
+		return true
+	}
+	return false
+}
+
+// awardChestContents gives the player a chest's contents and returns the
+// message to show. Contents are "rupees:N", "key", "map", "compass",
+// "stone_beak", "nightmare_key", "heart_piece", "mirror_shield" or an equip
+// item key.
+func (g *Game) awardChestContents(contents, flag string) []string {
+	inv := &g.Player.Inventory
+	d := g.CurrentDungeon
+
+	key, arg, _ := strings.Cut(contents, ":")
+	switch key {
+	case "rupees":
+		amount, err := strconv.Atoi(arg)
+		if err != nil || amount <= 0 {
+			amount = 20
+		}
+		inv.Rupees += amount
+		return []string{fmt.Sprintf("You got %d Rupees!", amount)}
+	case "key":
+		d.SmallKeys++
+		return []string{"You got a Small Key!", "Use it to open a locked door."}
+	case "map":
+		d.HasMap = true
+		return []string{"You found the Dungeon Map!", "View it from the inventory with SPACE."}
+	case "compass":
+		d.HasCompass = true
+		return []string{"You found the Compass!", "Chests and the boss now show on the map."}
+	case "stone_beak":
+		d.HasStoneBeak = true
+		return []string{"You found the Stone Beak!", "Owl statues in here will now speak."}
+	case "nightmare_key":
+		d.HasNightmareKey = true
+		return []string{"You got the Nightmare Key!", "It opens the door to the boss."}
+	case "heart_piece":
+		g.Quest.HeartPieces[flag] = true
+		if len(g.Quest.HeartPieces)%4 == 0 {
+			g.Player.MaxHP += 2
+			g.Player.HP = g.Player.MaxHP
+			return []string{"You got a Piece of Heart!", "Your life grew by one heart!"}
+		}
+		return []string{"You got a Piece of Heart!",
+			fmt.Sprintf("Collect %d more for a new heart.", 4-len(g.Quest.HeartPieces)%4)}
+	case "mirror_shield":
+		g.grantEquipItem(entity.EquipShield)
+		inv.ShieldLevel = 2
+		d.HasDungeonItem = true
+		return []string{"You got the Mirror Shield!", "It can turn back even the strongest shots."}
+	}
+
+	id := entity.EquipItemByKey(key)
+	if id == entity.EquipNone {
+		return []string{"The chest is empty."}
+	}
+	g.grantEquipItem(id)
+	d.HasDungeonItem = true
+	return []string{fmt.Sprintf("You got the %s!", entity.EquipItemName(id))}
+}
+
+// grantEquipItem adds an equippable item to the inventory, with any ammo or
+// level it comes with, and assigns it to a free button.
+func (g *Game) grantEquipItem(id entity.EquipItemID) {
+	inv := &g.Player.Inventory
+	inv.OwnedItems[id] = true
+
+	switch id {
+	case entity.EquipSword:
+		g.Player.HasSword = true
+		if inv.SwordLevel == 0 {
+			inv.SwordLevel = 1
+		}
+	case entity.EquipShield:
+		if inv.ShieldLevel == 0 {
+			inv.ShieldLevel = 1
+		}
+	case entity.EquipPowerBracelet:
+		if inv.BraceletLevel == 0 {
+			inv.BraceletLevel = 1
+		}
+	case entity.EquipBow:
+		inv.Arrows = inv.ArrowsMax
+	case entity.EquipBomb:
+		inv.Bombs += 10
+		if inv.Bombs > inv.BombsMax {
+			inv.Bombs = inv.BombsMax
+		}
+	case entity.EquipMagicPowder:
+		inv.Powder = inv.PowderMax
+	}
+
+	if inv.ButtonA == entity.EquipNone {
+		inv.ButtonA = id
+	} else if inv.ButtonB == entity.EquipNone {
+		inv.ButtonB = id
+	}
+}
@@ -5,6 +5,7 @@ import "github.com/AchrafSoltani/GlowQuest/entity"
 type DialogueState struct {
 	Active      bool
 	NPC         *entity.NPC
+	Name        string   // speaker shown above the text; empty for plain messages
 	Lines       []string // the active lines being displayed
 	CurrentLine int
 }
@@ -13,6 +14,7 @@ type DialogueState struct {
 func (d *DialogueState) Start(npc *entity.NPC) {
 	d.Active = true
 	d.NPC = npc
+	d.Name = npc.Name
 	d.Lines = npc.Dialogue
 	d.CurrentLine = 0
 }
@@ -21,6 +23,16 @@ func (d *DialogueState) Start(npc *entity.NPC) {
 func (d *DialogueState) StartWithLines(npc *entity.NPC, lines []string) {
 	d.Active = true
 	d.NPC = npc
+	d.Name = npc.Name
+	d.Lines = lines
+	d.CurrentLine = 0
+}
+
+// StartMessage shows a message box that isn't tied to an NPC.
+func (d *DialogueState) StartMessage(name string, lines []string) {
+	d.Active = true
+	d.NPC = nil
+	d.Name = name
 	d.Lines = lines
 	d.CurrentLine = 0
 }
@@ -31,6 +43,7 @@ func (d *DialogueState) Advance() bool {
 	if d.CurrentLine >= len(d.Lines) {
 		d.Active = false
 		d.NPC = nil
+		d.Name = ""
 		d.Lines = nil
 		d.CurrentLine = 0
 		return true
@@ -0,0 +1,344 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/save"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// loadDungeon returns the dungeon for a map ID, loading it on first use.
+// Loaded dungeons are kept so their state survives leaving and re-entering.
+func (g *Game) loadDungeon(id string) *world.Dungeon {
+	if d, ok := g.Dungeons[id]; ok {
+		return d
+	}
+	d := world.LoadDungeon(id)
+	if d == nil {
+		return nil
+	}
+	g.Dungeons[id] = d
+	return d
+}
+
+// enterDungeon places the player in the entrance room of a dungeon.
+func (g *Game) enterDungeon(d *world.Dungeon, link *world.DoorLink) {
+	g.InInterior = false
+	g.CurrentInterior = nil
+	g.Location = LocationDungeon
+	g.CurrentDungeon = d
+	g.ReturnLink = link
+
+	d.CurrentRoom = d.EntrancePos
+	d.VisitedRooms[d.CurrentRoom] = true
+
+	g.Player.X = link.SpawnX
+	g.Player.Y = link.SpawnY
+	if g.Player.X == 0 && g.Player.Y == 0 {
+		// Default: just inside the south doorway
+		g.Player.X = float64(config.PlayAreaWidth/2 - g.Player.Width/2)
+		g.Player.Y = float64(config.PlayAreaHeight-config.TileSize-g.Player.Height) - 1
+	}
+
+	g.spawnScreenEntities()
+	g.SaveGame()
+}
+
+// dungeonRoomKey identifies the current dungeon room for item tracking.
+func (g *Game) dungeonRoomKey() string {
+	d := g.CurrentDungeon
+	return fmt.Sprintf("dgn_%s_%d,%d", d.MapID, d.CurrentRoom[0], d.CurrentRoom[1])
+}
+
+func (g *Game) spawnDungeonRoomEntities() {
+	room := g.CurrentDungeon.CurrentDungeonRoom()
+	if room == nil {
+		return
+	}
+
+	// Rooms cleared by defeating their enemies stay empty
+	if !room.Cleared || room.ClearCondition == world.ClearPuzzle {
+		for _, es := range room.EnemySpawns {
+			g.Enemies = append(g.Enemies, spawnEnemy(es))
+		}
+	}
+
+	if room.NeedsClearing() {
+		room.Sealed = true
+		room.ApplyDoors()
+	}
+	g.weighSwitches(room)
+	if room.Cleared {
+		g.spawnClearDrop(room)
+	}
+}
+
+// spawnClearDrop places a room's clear reward unless it was already collected.
+func (g *Game) spawnClearDrop(room *world.DungeonRoom) {
+	if room.ClearDrop == nil {
+		return
+	}
+	key := g.dungeonRoomKey() + "_clear"
+	if g.CollectedItems[key] {
+		return
+	}
+	item := entity.NewItem(entity.ItemType(room.ClearDrop.Type),
+		float64(room.ClearDrop.TileX*config.TileSize)+2,
+		float64(room.ClearDrop.TileY*config.TileSize)+2)
+	item.SaveKey = key
+	g.Items = append(g.Items, item)
+}
+
+// updateRoomClear opens a sealed room once its clear condition is met,
+// revealing hidden chests and the room's drop.
+func (g *Game) updateRoomClear() {
+	d := g.CurrentDungeon
+	room := d.CurrentDungeonRoom()
+	if room == nil || !room.NeedsClearing() || !g.roomConditionMet(room) {
+		return
+	}
+
+	room.Cleared = true
+	room.Sealed = false
+	d.ClearedRooms[[2]int{room.X, room.Y}] = true
+	room.ApplyDoors()
+	room.RevealChests()
+	g.spawnClearDrop(room)
+	g.Audio.PlayDoorOpen()
+	g.SaveGame()
+}
+
+func (g *Game) roomConditionMet(room *world.DungeonRoom) bool {
+	switch room.ClearCondition {
+	case world.ClearKillAll:
+		for _, e := range g.Enemies {
+			if !e.Dead {
+				return false
+			}
+		}
+		return true
+	case world.ClearBoss:
+		for _, e := range g.Enemies {
+			if e.Type == entity.EnemyBoss && !e.Dead {
+				return false
+			}
+		}
+		return true
+	case world.ClearPuzzle:
+		return roomPuzzleSolved(room)
+	case world.ClearSwitch:
+		return room.TargetActive(room.ClearTarget)
+	}
+	return true
+}
+
+// roomPuzzleSolved returns true once no floor switch in the room is left
+// unpressed and no torch left unlit.
+func roomPuzzleSolved(room *world.DungeonRoom) bool {
+	if !room.SwitchesPressed() {
+		return false
+	}
+	for gy := 0; gy < config.ScreenGridH; gy++ {
+		for gx := 0; gx < config.ScreenGridW; gx++ {
+			switch room.Screen.Tiles[gy][gx] {
+			case world.TileSwitchOff, world.TileTorch:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// handleDungeonEdgeCrossing scrolls to the neighbouring room when the player
+// walks out through a doorway. Leaving through the entrance door returns to
+// the overworld, and one-way doors can't be left through.
+func (g *Game) handleDungeonEdgeCrossing(crossX, crossY int) {
+	side := -1
+	switch {
+	case crossY < 0:
+		side = world.SideNorth
+	case crossY > 0:
+		side = world.SideSouth
+	case crossX > 0:
+		side = world.SideEast
+	case crossX < 0:
+		side = world.SideWest
+	}
+	if side < 0 {
+		return
+	}
+
+	d := g.CurrentDungeon
+	room := d.CurrentDungeonRoom()
+	if room == nil {
+		// Lost track of the room: put the player back at the entrance
+		g.clampPlayer()
+		d.CurrentRoom = d.EntrancePos
+		d.VisitedRooms[d.CurrentRoom] = true
+		g.spawnScreenEntities()
+		return
+	}
+	if room.Doors[side] != nil && room.Doors[side].Type == world.DoorEntrance {
+		g.clampPlayer()
+		if g.ReturnLink != nil {
+			g.PendingExitLink = g.ReturnLink
+			g.Transition.StartFade()
+			g.Audio.PlayDoorOpen()
+		}
+		return
+	}
+	if door := room.Doors[side]; door != nil && door.Type == world.DoorOneWay && !door.Opened {
+		// One-way doors only let the player in
+		g.clampPlayer()
+		return
+	}
+
+	dirX, dirY := world.SideOffset(side)
+	next := d.RoomAt(d.CurrentRoom[0]+dirX, d.CurrentRoom[1]+dirY)
+	if next == nil {
+		g.clampPlayer()
+		return
+	}
+
+	oldScreen := room.Screen
+	d.CurrentRoom = [2]int{next.X, next.Y}
+	d.VisitedRooms[d.CurrentRoom] = true
+
+	// Land one tile inside the new room, clear of its doorway
+	ts := float64(config.TileSize)
+	if dirX == 1 {
+		g.Player.X = ts + 1
+	} else if dirX == -1 {
+		g.Player.X = float64(config.PlayAreaWidth-g.Player.Width) - ts - 1
+	}
+	if dirY == 1 {
+		g.Player.Y = ts + 1
+	} else if dirY == -1 {
+		g.Player.Y = float64(config.PlayAreaHeight-g.Player.Height) - ts - 1
+	}
+
+	g.Transition.Start(dirX, dirY, oldScreen)
+	g.spawnScreenEntities()
+	g.SaveGame()
+}
+
+// tryInteractDungeonDoor opens the door the player is facing if they carry
+// what it needs: a small key or the nightmare key. Bombable doors are blown
+// open by explosions instead.
+func (g *Game) tryInteractDungeonDoor() bool {
+	d := g.CurrentDungeon
+	room := d.CurrentDungeonRoom()
+	if room == nil {
+		return false
+	}
+
+	px := int(g.Player.CenterX()) / config.TileSize
+	py := int(g.Player.CenterY()) / config.TileSize
+	tx := px + int(g.Player.Dir.DX())
+	ty := py + int(g.Player.Dir.DY())
+
+	side := world.SideAt(tx, ty)
+	if side < 0 || room.Doors[side] == nil || room.Doors[side].Opened {
+		return false
+	}
+
+	switch room.Doors[side].Type {
+	case world.DoorLocked, world.DoorKeyBlock:
+		if d.SmallKeys <= 0 {
+			return false
+		}
+		d.SmallKeys--
+	case world.DoorBoss:
+		if !d.HasNightmareKey {
+			return false
+		}
+	default:
+		return false
+	}
+
+	d.OpenDoor(room.X, room.Y, side)
+	g.Audio.PlayDoorOpen()
+	g.SaveGame()
+	return true
+}
+
+// dungeonProgress converts a dungeon's per-save state for the save file.
+func dungeonProgress(d *world.Dungeon) *save.DungeonSaveData {
+	ds := &save.DungeonSaveData{
+		MapID:           d.MapID,
+		SmallKeys:       d.SmallKeys,
+		HasMap:          d.HasMap,
+		HasCompass:      d.HasCompass,
+		HasStoneBeak:    d.HasStoneBeak,
+		HasNightmareKey: d.HasNightmareKey,
+		HasDungeonItem:  d.HasDungeonItem,
+		OpenedChests:    d.OpenedChests,
+		OpenedDoors:     d.OpenedDoors,
+		PressedSwitches: d.PressedSwitches,
+		BlockSpots:      d.BlockSpots,
+	}
+	for pos := range d.VisitedRooms {
+		ds.VisitedRooms = append(ds.VisitedRooms, pos)
+	}
+	for pos := range d.ClearedRooms {
+		ds.ClearedRooms = append(ds.ClearedRooms, pos)
+	}
+	return ds
+}
+
+// restoreDungeonProgress loads a dungeon and applies saved progress to it.
+func (g *Game) restoreDungeonProgress(ds *save.DungeonSaveData) {
+	d := g.loadDungeon(ds.MapID)
+	if d == nil {
+		return
+	}
+	d.SmallKeys = ds.SmallKeys
+	d.HasMap = ds.HasMap
+	d.HasCompass = ds.HasCompass
+	d.HasStoneBeak = ds.HasStoneBeak
+	d.HasNightmareKey = ds.HasNightmareKey
+	d.HasDungeonItem = ds.HasDungeonItem
+	for _, pos := range ds.VisitedRooms {
+		d.VisitedRooms[pos] = true
+	}
+	for _, pos := range ds.ClearedRooms {
+		d.ClearedRooms[pos] = true
+	}
+	for flag, ok := range ds.OpenedChests {
+		d.OpenedChests[flag] = ok
+	}
+	for key, ok := range ds.OpenedDoors {
+		d.OpenedDoors[key] = ok
+	}
+	for key, ok := range ds.PressedSwitches {
+		d.PressedSwitches[key] = ok
+	}
+	for key, spots := range ds.BlockSpots {
+		d.BlockSpots[key] = spots
+	}
+	d.RestoreState()
+}
+
+// resumeDungeon puts a continued game back in the saved dungeon room.
+func (g *Game) resumeDungeon(id string, roomX, roomY int) {
+	d := g.loadDungeon(id)
+	if d == nil {
+		return
+	}
+	g.Location = LocationDungeon
+	g.CurrentDungeon = d
+	d.CurrentRoom = [2]int{roomX, roomY}
+	if d.RoomAt(roomX, roomY) == nil {
+		// The room is gone from the dungeon file; start over at the entrance
+		d.CurrentRoom = d.EntrancePos
+	}
+	d.VisitedRooms[d.CurrentRoom] = true
+	for i := range g.DoorLinks {
+		if g.DoorLinks[i].DungeonID == id {
+			g.ReturnLink = &g.DoorLinks[i]
+			break
+		}
+	}
+}
@@ -44,6 +44,8 @@ type Game struct {
 	// Persistent item tracking — items collected are remembered by "sx,sy,idx"
 	CollectedItems map[string]bool
 	UnlockedDoors  map[string]bool
+	BombedWalls    map[string]bool // "<screen key>_tx,ty" of walls blown open
+	SongDoors      map[string]bool // "<screen key>_tx,ty" of song doors opened
 
 	// Interiors
 	Interiors       map[string]*world.InteriorDef
@@ -55,14 +57,16 @@ type Game struct {
 	// Location tracking
 	Location LocationType
 
-	// Dungeon support (Phase 8+)
+	// Dungeons
 	CurrentDungeon *world.Dungeon
+	Dungeons       map[string]*world.Dungeon // loaded dungeons by map ID
 
 	// Quest state
 	Quest *QuestState
 
 	// Fade transition state for interiors
 	PendingInterior *world.InteriorDef
+	PendingDungeon  *world.Dungeon
 	PendingDoorLink *world.DoorLink
 	PendingExitLink *world.DoorLink
 
@@ -76,13 +80,48 @@ type Game struct {
 	Particles  *entity.ParticlePool
 	ShakeTimer float64
 	FlashTimer float64
+	AnimTime   float64 // seconds since launch, for tile animations
 
 	// Boss
 	BossDefeated bool
 
+	// Bombs
+	Bombs       []*entity.Bomb
+	Explosions  []*entity.Explosion
+	CarriedBomb *entity.Bomb
+
+	// Rocks and pots picked up with the Power Bracelet
+	Lifted        []*entity.Lifted
+	CarriedObject *entity.Lifted
+
+	// Hookshot chain and boomerang while they are out
+	Hookshot  *entity.Hookshot
+	Boomerang *entity.Boomerang
+
+	// Holes dug with the shovel on the current screen
+	Holes []digHole
+
+	// Blocks sliding after a push, and which floor switches were weighed
+	// down last frame
+	Blocks       []slidingBlock
+	SwitchWeight map[[2]int]bool
+
+	// Where the player came into the current room, for pit respawns, and
+	// the pit warp being fallen through
+	EntryX, EntryY float64
+	PendingPit     *world.PitWarp
+
 	// Inventory screen cursor
 	InventoryCursorX int
 	InventoryCursorY int
+
+	// Ocarina song picker cursor and the song being played
+	SongCursor  int
+	SongPlaying entity.SongID
+	SongTimer   float64
+
+	// Chest contents held overhead while the item-get message is shown
+	HeldItem string
 }
 
 func NewGame() *Game {
@@ -93,6 +132,9 @@ func NewGame() *Game {
 		RNG:            system.NewSimpleRNG(42),
 		CollectedItems: make(map[string]bool),
 		UnlockedDoors:  make(map[string]bool),
+		BombedWalls:    make(map[string]bool),
+		SongDoors:      make(map[string]bool),
+		Dungeons:       make(map[string]*world.Dungeon),
 		Audio:          audio.NewEngine(),
 		Menu:           NewMenuState(save.Exists()),
 		Particles:      entity.NewParticlePool(),
@@ -105,6 +147,7 @@ func (g *Game) initWorld() {
 	g.Overworld = world.NewOverworld()
 	g.Interiors, _ = world.LoadInteriors()
 	g.DoorLinks = world.BuildDoorLinksFromScreens(g.Overworld.Screens)
+	g.Dungeons = make(map[string]*world.Dungeon)
 }
 
 func (g *Game) StartNewGame() {
@@ -117,6 +160,8 @@ func (g *Game) StartNewGame() {
 	g.Player = entity.NewPlayer(startX, startY)
 	g.CollectedItems = make(map[string]bool)
 	g.UnlockedDoors = make(map[string]bool)
+	g.BombedWalls = make(map[string]bool)
+	g.SongDoors = make(map[string]bool)
 	g.InInterior = false
 	g.CurrentInterior = nil
 	g.ReturnLink = nil
@@ -201,6 +246,7 @@ func (g *Game) StartContinue() {
 	if data.Version >= 2 {
 		g.Player.Inventory.Bombs = data.Bombs
 		g.Player.Inventory.Arrows = data.Arrows
+		g.Player.Inventory.Powder = data.Powder
 		g.Player.Inventory.SwordLevel = data.SwordLevel
 		g.Player.Inventory.ShieldLevel = data.ShieldLevel
 		g.Player.Inventory.BraceletLevel = data.BraceletLevel
@@ -217,6 +263,13 @@ func (g *Game) StartContinue() {
 			}
 			g.Quest.DungeonsCompleted = data.Quest.DungeonsCompleted
 			g.Quest.TradingItem = data.Quest.TradingItem
+			for _, key := range data.Quest.HeartPieces {
+				g.Quest.HeartPieces[key] = true
+			}
+			for _, key := range data.Quest.WarpPoints {
+				g.Quest.WarpPoints[key] = true
+			}
+			g.learnSongs()
 		}
 	}
 
@@ -239,6 +292,14 @@ func (g *Game) StartContinue() {
 	if g.UnlockedDoors == nil {
 		g.UnlockedDoors = make(map[string]bool)
 	}
+	g.BombedWalls = data.BombedWalls
+	if g.BombedWalls == nil {
+		g.BombedWalls = make(map[string]bool)
+	}
+	g.SongDoors = data.SongDoors
+	if g.SongDoors == nil {
+		g.SongDoors = make(map[string]bool)
+	}
 	g.BossDefeated = data.BossDefeated
 	g.Particles = entity.NewParticlePool()
 	g.ShakeTimer = 0
@@ -264,6 +325,16 @@ func (g *Game) StartContinue() {
 		}
 	}
 
+	// Restore per-dungeon progress, then the dungeon room we saved in
+	for _, ds := range data.Dungeons {
+		if ds != nil {
+			g.restoreDungeonProgress(ds)
+		}
+	}
+	if LocationType(data.LocationType) == LocationDungeon && data.DungeonID != "" {
+		g.resumeDungeon(data.DungeonID, data.DungeonRoomX, data.DungeonRoomY)
+	}
+
 	g.State = StatePlaying
 	g.spawnScreenEntities()
 }
@@ -278,6 +349,7 @@ func (g *Game) SaveGame() {
 		Keys:           g.Player.Inventory.Keys,
 		Bombs:          g.Player.Inventory.Bombs,
 		Arrows:         g.Player.Inventory.Arrows,
+		Powder:         g.Player.Inventory.Powder,
 		SwordLevel:     g.Player.Inventory.SwordLevel,
 		ShieldLevel:    g.Player.Inventory.ShieldLevel,
 		BraceletLevel:  g.Player.Inventory.BraceletLevel,
@@ -285,12 +357,15 @@ func (g *Game) SaveGame() {
 		ButtonB:        int(g.Player.Inventory.ButtonB),
 		CollectedItems: g.CollectedItems,
 		UnlockedDoors:  g.UnlockedDoors,
+		BombedWalls:    g.BombedWalls,
+		SongDoors:      g.SongDoors,
 		ScreenX:        g.Overworld.CurrentX,
 		ScreenY:        g.Overworld.CurrentY,
 		PlayerX:        g.Player.X,
 		PlayerY:        g.Player.Y,
 		InInterior:     g.InInterior,
 		BossDefeated:   g.BossDefeated,
+		LocationType:   int(g.Location),
 	}
 
 	// Save owned items as int slice
@@ -304,10 +379,28 @@ func (g *Game) SaveGame() {
 		DungeonsCompleted:  g.Quest.DungeonsCompleted,
 		TradingItem:        g.Quest.TradingItem,
 	}
+	for key := range g.Quest.HeartPieces {
+		data.Quest.HeartPieces = append(data.Quest.HeartPieces, key)
+	}
+	for key := range g.Quest.WarpPoints {
+		data.Quest.WarpPoints = append(data.Quest.WarpPoints, key)
+	}
+
+	// Save per-dungeon progress
+	for _, d := range g.Dungeons {
+		if d.ID >= 1 && d.ID <= 9 {
+			data.Dungeons[d.ID-1] = dungeonProgress(d)
+		}
+	}
 
 	if g.InInterior && g.CurrentInterior != nil {
 		data.InteriorID = g.CurrentInterior.ID
 	}
+	if g.Location == LocationDungeon && g.CurrentDungeon != nil {
+		data.DungeonID = g.CurrentDungeon.MapID
+		data.DungeonRoomX = g.CurrentDungeon.CurrentRoom[0]
+		data.DungeonRoomY = g.CurrentDungeon.CurrentRoom[1]
+	}
 	save.Save(data)
 }
 
@@ -328,6 +421,7 @@ func (g *Game) VolumeUp()    { g.Audio.VolumeUp() }
 func (g *Game) VolumeDown()  { g.Audio.VolumeDown() }
 
 func (g *Game) Update(dt float64) {
+	g.AnimTime += dt
 	switch g.State {
 	case StateMenu:
 		g.updateMenu()
@@ -341,6 +435,12 @@ func (g *Game) Update(dt float64) {
 		g.updateDialogue()
 	case StateInventory:
 		g.updateInventory()
+	case StateDungeonMap:
+		g.updateDungeonMap()
+	case StateSongSelect:
+		g.updateSongSelect()
+	case StateSongPlaying:
+		g.updateSongPlaying(dt)
 	case StatePlaying:
 		g.updatePlaying(dt)
 	}
@@ -404,6 +504,8 @@ func (g *Game) updateDialogue() {
 		done := g.Dialogue.Advance()
 		if done {
 			g.State = StatePlaying
+			g.Player.ItemGet = false
+			g.HeldItem = ""
 		}
 	}
 }
@@ -413,6 +515,13 @@ func (g *Game) updateInventory() {
 		g.State = StatePlaying
 	}
 
+	// Dungeon map (Space, inside a dungeon)
+	if g.Input.JustPressed(glow.KeySpace) && g.Location == LocationDungeon && g.CurrentDungeon != nil {
+		g.State = StateDungeonMap
+		g.Audio.PlayMenuSelect()
+		return
+	}
+
 	// Navigate inventory grid
 	if g.Input.JustPressed(glow.KeyUp) || g.Input.JustPressed(glow.KeyW) {
 		g.InventoryCursorY--
@@ -453,6 +562,15 @@ func (g *Game) updateInventory() {
 	}
 }
 
+func (g *Game) updateDungeonMap() {
+	if g.Input.JustPressed(glow.KeySpace) {
+		g.State = StateInventory
+	}
+	if g.Input.JustPressed(glow.KeyTab) || g.Input.JustPressed(glow.KeyEscape) {
+		g.State = StatePlaying
+	}
+}
+
 func (g *Game) updatePlaying(dt float64) {
 	// Check pause (Enter)
 	if g.Input.JustPressed(glow.KeyEnter) {
@@ -496,9 +614,20 @@ func (g *Game) updatePlaying(dt float64) {
 	// Update particles
 	g.Particles.Update(dt)
 
+	// A fall plays out before anything else moves
+	if g.Player.Falling {
+		g.updateFall(dt)
+		return
+	}
+
 	// Update sword swing
 	g.Player.Sword.Update(dt)
 
+	// Roc's Feather jump arc
+	if g.Player.UpdateJump(dt) {
+		system.LandPlayer(g.Player, g.currentScreen())
+	}
+
 	// Update invincibility timer
 	if g.Player.InvTimer > 0 {
 		g.Player.InvTimer -= dt
@@ -509,48 +638,61 @@ func (g *Game) updatePlaying(dt float64) {
 
 	// Space = interact (talk, read signs, open chests, use doors)
 	if g.Input.JustPressed(glow.KeySpace) {
+		if g.throwCarried() {
+			return
+		}
 		if g.tryInteractNPC() {
 			return
 		}
+		if g.tryReadSign() {
+			return
+		}
+		if g.tryLiftBomb() {
+			return
+		}
+		if g.tryOpenChest() {
+			return
+		}
+		if g.tryLiftTile() {
+			return
+		}
 		if g.tryInteractDoor() {
 			return
 		}
 	}
 
-	// Z = A button (use equipped item)
-	if g.Input.JustPressed(glow.KeyJ) && !g.Player.Sword.Active {
-		g.useEquippedItem(g.Player.Inventory.ButtonA)
-	}
+	// Bow and bombs pressed together fire a bomb-arrow
+	if g.comboPressed(entity.EquipBow, entity.EquipBomb) {
+		g.fireBombArrow()
+	} else {
+		// Z = A button (use equipped item)
+		if g.Input.JustPressed(glow.KeyJ) && !g.Player.Sword.Active {
+			g.useEquippedItem(g.Player.Inventory.ButtonA)
+		}
 
-	// X = B button (use equipped item)
-	if g.Input.JustPressed(glow.KeyK) && !g.Player.Sword.Active {
-		g.useEquippedItem(g.Player.Inventory.ButtonB)
+		// X = B button (use equipped item)
+		if g.Input.JustPressed(glow.KeyK) && !g.Player.Sword.Active {
+			g.useEquippedItem(g.Player.Inventory.ButtonB)
+		}
 	}
 
+	// Shield stays raised while its button is held
+	g.Player.Shielding = g.Player.Inventory.ShieldLevel > 0 && !g.Player.Sword.Active &&
+		!g.Player.Swimming && g.buttonHeld(entity.EquipShield)
+
 	// Combat: check sword hits
 	if g.Player.Sword.Active {
 		hitEnemies := system.CheckSwordHits(g.Player, g.Enemies)
 		for _, e := range hitEnemies {
-			e.HP--
-			e.InvTimer = config.EnemyInvTime
-			system.ApplyKnockback(e, g.Player.CenterX(), g.Player.CenterY())
-			if e.HP <= 0 {
-				e.Dead = true
-				g.Audio.PlayEnemyDie()
-				g.tryDropItem(e)
-				g.spawnDeathParticles(e)
-				// Check boss death
-				if e.Type == entity.EnemyBoss {
-					g.BossDefeated = true
-					g.State = StateVictory
-					g.VictoryTimer = 0
-					g.SaveGame()
-					return
-				}
-			} else {
-				g.Audio.PlayEnemyHit()
+			damage := 1
+			if g.Player.Sword.Jumping {
+				damage = 2
+			}
+			if g.hitEnemy(e, damage, g.Player.CenterX(), g.Player.CenterY()) {
+				return
 			}
 		}
+		g.cutBushes(system.CutWithSword(g.Player, g.currentScreen()))
 	}
 
 	// Destroy projectiles deflected by sword
@@ -560,6 +702,9 @@ func (g *Game) updatePlaying(dt float64) {
 		}
 	}
 
+	// Pegasus Boots: charge while held, then dash
+	g.updateDashCharge(dt)
+
 	// Read movement input
 	var dx, dy float64
 	if g.Input.IsHeld(glow.KeyUp) || g.Input.IsHeld(glow.KeyW) {
@@ -581,24 +726,89 @@ func (g *Game) updatePlaying(dt float64) {
 
 	g.Player.Moving = dx != 0 || dy != 0
 
-	if g.Player.Moving {
+	if g.Hookshot != nil {
+		// The hookshot holds the player until it is back or has pulled them over
+		g.updateHookshot(dt)
+	} else if g.Player.Dashing {
+		// The dash carries the player on regardless of input
+		if g.updateDash(dt) {
+			return
+		}
+	} else if g.Player.KnockbackTimer > 0 {
+		system.UpdatePlayerKnockback(g.Player, g.currentScreen(), dt)
+	} else {
+		// A ledge hop carries the player over regardless of input
+		if g.Player.LedgeHop {
+			g.Player.Dir = g.Player.HopDir
+			dx, dy = g.Player.Dir.DX(), g.Player.Dir.DY()
+			g.Player.Moving = true
+		}
+		// Ice and conveyors can carry the player even without input
 		screen := g.currentScreen()
 		crossX, crossY := system.MovePlayer(g.Player, screen, dx, dy, dt)
-		if !g.InInterior {
-			g.handleEdgeCrossing(crossX, crossY)
-		} else {
-			g.clampPlayer()
+		g.handlePlayerCrossing(crossX, crossY)
+
+		// Walking into a ledge from above hops down it
+		if g.Player.Moving && !g.Player.Jumping && system.FacingLedge(g.Player, screen) {
+			g.Player.StartLedgeHop()
+			g.Audio.PlayJump()
 		}
+
+		// Leaning on a block long enough pushes it
+		g.updatePush(dt)
+	}
+
+	// Pits and lava swallow the player; spikes hurt them
+	g.checkFall()
+	if g.checkDamagingTiles() {
+		return
+	}
+
+	// Swim, dive or drown depending on the water underfoot
+	if g.updateSwimming(dt) {
+		return
 	}
 
 	// Check door entry (standing on door/stairs tile)
 	g.checkDoorEntry()
+	g.checkWarpPoint()
 
 	// Update enemies
 	g.updateEnemies(dt)
 
+	// Slide pushed blocks, press floor switches and open sealed dungeon
+	// rooms once cleared
+	if g.Location == LocationDungeon {
+		g.updateBlocks(dt)
+		g.updateSwitches()
+		g.updateRoomClear()
+	}
+
+	// Update bombs and explosions
+	if g.updateBombs(dt) {
+		return
+	}
+
+	// Update lifted and thrown objects
+	if g.updateLifted(dt) {
+		return
+	}
+
+	// Update the boomerang
+	if g.updateBoomerang(dt) {
+		return
+	}
+
 	// Update projectiles
-	g.updateProjectiles(dt)
+	if g.updateProjectiles(dt) {
+		return
+	}
+	if g.checkPlayerProjectileHits() {
+		return
+	}
+
+	// Refill dug holes
+	g.updateHoles(dt)
 
 	// Update items
 	g.updateItems(dt)
@@ -617,15 +827,99 @@ func (g *Game) updatePlaying(dt float64) {
 
 // useEquippedItem activates the item assigned to a button.
 func (g *Game) useEquippedItem(item entity.EquipItemID) {
-	switch item {
-	case entity.EquipSword:
-		if g.Player.HasSword || g.Player.Inventory.SwordLevel > 0 {
-			g.Player.Sword.Start(g.Player.Dir)
-			g.Audio.PlaySwordSwing()
-		}
-	// Other items will be implemented in later phases
-	case entity.EquipNone:
-		// nothing
+	// Either button throws whatever is being carried
+	if g.throwCarried() {
+		return
+	}
+	if g.Hookshot != nil {
+		return
+	}
+
+	res := system.UseItem(item, g.Player)
+	if res.Dive {
+		g.Player.StartDive()
+		g.spawnSplash()
+	}
+	if res.SwordSwing {
+		g.Player.Sword.Start(g.Player.Dir)
+		g.Player.Sword.Jumping = g.Player.Jumping
+		g.Audio.PlaySwordSwing()
+	}
+	if res.Jump {
+		g.Player.StartJump()
+		g.Audio.PlayJump()
+	}
+	if res.Dash {
+		g.Player.StartDashCharge()
+	}
+	if res.PlaceBomb {
+		g.placeBomb()
+	}
+	if res.ShootArrow {
+		g.fireArrow(false)
+	}
+	if res.Lift {
+		g.tryLiftTile()
+	}
+	if res.Hookshot {
+		g.fireHookshot()
+	}
+	if res.Boomerang {
+		g.throwBoomerang()
+	}
+	if res.Fire {
+		g.castFireball()
+	}
+	if res.Dig {
+		g.dig()
+	}
+	if res.Powder {
+		g.sprinklePowder()
+	}
+	if res.Ocarina {
+		g.openSongPicker()
+	}
+	if res.Shield {
+		g.Player.Shielding = true
+	}
+}
+
+// hitEnemy damages an enemy and knocks it away from the source. Returns true
+// if defeating it ended the game.
+func (g *Game) hitEnemy(e *entity.Enemy, damage int, fromX, fromY float64) bool {
+	e.HP -= damage
+	e.InvTimer = config.EnemyInvTime
+	system.ApplyKnockback(e, fromX, fromY)
+	if e.HP > 0 {
+		g.Audio.PlayEnemyHit()
+		return false
+	}
+
+	e.Dead = true
+	g.Audio.PlayEnemyDie()
+	g.tryDropItem(e)
+	g.spawnDeathParticles(e)
+	// Check boss death — dungeon bosses complete their dungeon instead of ending the game
+	if e.Type == entity.EnemyBoss && g.Location == LocationDungeon {
+		g.Quest.CompleteDungeon(int(g.CurrentDungeon.ID))
+	} else if e.Type == entity.EnemyBoss {
+		g.BossDefeated = true
+		g.State = StateVictory
+		g.VictoryTimer = 0
+		g.SaveGame()
+		return true
+	}
+	return false
+}
+
+// handlePlayerCrossing reacts to the player moving past a play-area edge.
+func (g *Game) handlePlayerCrossing(crossX, crossY int) {
+	if g.Location == LocationDungeon {
+		g.handleDungeonEdgeCrossing(crossX, crossY)
+	} else if !g.InInterior {
+		g.handleEdgeCrossing(crossX, crossY)
+	} else {
+		g.clampPlayer()
 	}
 }
 
@@ -681,6 +975,11 @@ func (g *Game) clampPlayer() {
 }
 
 func (g *Game) currentScreen() *world.Screen {
+	if g.Location == LocationDungeon && g.CurrentDungeon != nil {
+		if room := g.CurrentDungeon.CurrentDungeonRoom(); room != nil {
+			return room.Screen
+		}
+	}
 	if g.InInterior && g.CurrentInterior != nil {
 		return g.CurrentInterior.Screen
 	}
@@ -692,10 +991,31 @@ func (g *Game) spawnScreenEntities() {
 	g.Projectiles = nil
 	g.Items = nil
 	g.NPCs = nil
+	g.Bombs = nil
+	g.Explosions = nil
+	g.CarriedBomb = nil
+	g.Lifted = nil
+	g.CarriedObject = nil
+	g.Hookshot = nil
+	g.Boomerang = nil
+	g.Holes = nil
+	g.settleBlocks()
+	g.SwitchWeight = nil
+	g.Player.Lifting = false
+	g.EntryX, g.EntryY = g.Player.X, g.Player.Y
 
 	var screen *world.Screen
 	var screenKey string
 
+	g.currentScreen().Regrow()
+	g.applyBombedWalls(g.currentScreen())
+	g.applySongDoors(g.currentScreen())
+
+	if g.Location == LocationDungeon && g.CurrentDungeon != nil {
+		g.spawnDungeonRoomEntities()
+		return
+	}
+
 	if g.InInterior && g.CurrentInterior != nil {
 		screen = g.CurrentInterior.Screen
 		screenKey = g.CurrentInterior.ID
@@ -712,6 +1032,7 @@ func (g *Game) spawnScreenEntities() {
 			item := entity.NewItem(entity.ItemType(is.Type),
 				float64(is.TileX*config.TileSize)+2,
 				float64(is.TileY*config.TileSize)+2)
+			item.Hidden = is.Hidden && !world.TileProps[screen.TileAt(is.TileX, is.TileY)].Passable
 			g.Items = append(g.Items, item)
 		}
 		for _, ns := range g.CurrentInterior.NPCSpawns {
@@ -724,6 +1045,8 @@ func (g *Game) spawnScreenEntities() {
 				ns.Dialogue,
 				ns.ConditionalDialogues,
 			)
+			npc.Asleep = ns.Asleep && !g.Quest.HasFlag("awake_"+ns.ID)
+			npc.PowderFlag = ns.PowderFlag
 			g.NPCs = append(g.NPCs, npc)
 		}
 		_ = screen
@@ -758,6 +1081,7 @@ func (g *Game) spawnScreenEntities() {
 		item := entity.NewItem(entity.ItemType(is.Type),
 			float64(is.TileX*config.TileSize)+2,
 			float64(is.TileY*config.TileSize)+2)
+		item.Hidden = is.Hidden && !world.TileProps[screen.TileAt(is.TileX, is.TileY)].Passable
 		g.Items = append(g.Items, item)
 	}
 
@@ -771,6 +1095,8 @@ func (g *Game) spawnScreenEntities() {
 			ns.Dialogue,
 			ns.ConditionalDialogues,
 		)
+		npc.Asleep = ns.Asleep && !g.Quest.HasFlag("awake_"+ns.ID)
+		npc.PowderFlag = ns.PowderFlag
 		g.NPCs = append(g.NPCs, npc)
 	}
 }
@@ -778,7 +1104,14 @@ func (g *Game) spawnScreenEntities() {
 func spawnEnemy(es world.EnemySpawn) *entity.Enemy {
 	x := float64(es.TileX*config.TileSize) + 1
 	y := float64(es.TileY*config.TileSize) + 1
-	switch entity.EnemyType(es.Type) {
+	return newEnemy(entity.EnemyType(es.Type), x, y)
+}
+
+// newEnemy creates an enemy of the given type at a pixel position.
+func newEnemy(t entity.EnemyType, x, y float64) *entity.Enemy {
+	switch t {
+	case entity.EnemyIceOctorok:
+		return entity.NewIceOctorok(x, y)
 	case entity.EnemyMoblin:
 		return entity.NewMoblin(x, y)
 	case entity.EnemyStalfos:
@@ -800,22 +1133,49 @@ func (g *Game) updateEnemies(dt float64) {
 		if proj != nil {
 			g.Projectiles = append(g.Projectiles, proj)
 		}
+		// Only knockback can carry an enemy over a pit
+		if system.EnemyOverPit(e, screen) {
+			e.Dead = true
+			g.spawnDeathParticles(e)
+			g.Audio.PlayEnemyDie()
+		}
 	}
 }
 
-func (g *Game) updateProjectiles(dt float64) {
+// updateProjectiles moves projectiles and resolves their wall hits. Returns
+// true if a bomb-arrow blast ended the game.
+func (g *Game) updateProjectiles(dt float64) bool {
+	ended := false
 	alive := g.Projectiles[:0]
 	for _, p := range g.Projectiles {
+		wasStuck := p.Stuck
 		p.Update(dt)
 		screen := g.currentScreen()
-		if system.TileCollision(screen, p.X, p.Y, p.Width, p.Height) {
+		if !wasStuck && p.IsArrow() && g.arrowHitSwitch(p) {
 			p.Dead = true
 		}
+		if p.Kind == entity.ProjectileFireball && g.burnTiles(p) {
+			p.Dead = true
+			g.Audio.PlayFireball()
+		}
+		if !wasStuck && !p.Dead && system.TileCollision(screen, p.X, p.Y, p.Width, p.Height) {
+			switch p.Kind {
+			case entity.ProjectileArrow:
+				p.Stick()
+				g.Audio.PlayShieldBlock()
+			case entity.ProjectileBombArrow:
+				p.Dead = true
+				ended = ended || g.explode(p.CenterX(), p.CenterY())
+			default:
+				p.Dead = true
+			}
+		}
 		if !p.Dead {
 			alive = append(alive, p)
 		}
 	}
 	g.Projectiles = alive
+	return ended
 }
 
 func (g *Game) updateItems(dt float64) {
@@ -826,23 +1186,31 @@ func (g *Game) updateItems(dt float64) {
 	}
 }
 
+// screenKey identifies the current screen, interior or dungeon room for
+// persistent per-screen state.
+func (g *Game) screenKey() string {
+	if g.Location == LocationDungeon && g.CurrentDungeon != nil {
+		return g.dungeonRoomKey()
+	} else if g.InInterior && g.CurrentInterior != nil {
+		return "int_" + g.CurrentInterior.ID
+	}
+	return fmt.Sprintf("%d,%d", g.Overworld.CurrentX, g.Overworld.CurrentY)
+}
+
 func (g *Game) checkItemPickup() {
 	px, py, pw, ph := g.Player.BBox()
-
-	var screenKey string
-	if g.InInterior && g.CurrentInterior != nil {
-		screenKey = "int_" + g.CurrentInterior.ID
-	} else {
-		screenKey = fmt.Sprintf("%d,%d", g.Overworld.CurrentX, g.Overworld.CurrentY)
-	}
+	screenKey := g.screenKey()
 
 	for i, item := range g.Items {
-		if item.Collected {
+		if item.Collected || item.Hidden {
 			continue
 		}
 		if system.AABBOverlap(px, py, pw, ph, item.X, item.Y, float64(item.Width), float64(item.Height)) {
 			item.Collected = true
-			key := fmt.Sprintf("%s_%d", screenKey, i)
+			key := item.SaveKey
+			if key == "" {
+				key = fmt.Sprintf("%s_%d", screenKey, i)
+			}
 			g.CollectedItems[key] = true
 			g.applyItemEffect(item)
 			g.Audio.PlayItemPickup()
@@ -877,6 +1245,31 @@ func (g *Game) applyItemEffect(item *entity.Item) {
 	case entity.ItemHeartContainer:
 		g.Player.MaxHP += 2
 		g.Player.HP = g.Player.MaxHP
+	case entity.ItemArrows:
+		inv := &g.Player.Inventory
+		inv.Arrows += config.ArrowRefill
+		if inv.Arrows > inv.ArrowsMax {
+			inv.Arrows = inv.ArrowsMax
+		}
+	case entity.ItemBombs:
+		inv := &g.Player.Inventory
+		inv.Bombs += config.BombRefill
+		if inv.Bombs > inv.BombsMax {
+			inv.Bombs = inv.BombsMax
+		}
+	case entity.ItemSeashell:
+		g.Player.Inventory.SecretSeashells++
+	case entity.ItemPowder:
+		inv := &g.Player.Inventory
+		inv.Powder += config.PowderRefill
+		if inv.Powder > inv.PowderMax {
+			inv.Powder = inv.PowderMax
+		}
+	case entity.ItemFairy:
+		g.Player.HP += config.FairyHeal
+		if g.Player.HP > g.Player.MaxHP {
+			g.Player.HP = g.Player.MaxHP
+		}
 	}
 }
 
@@ -886,9 +1279,13 @@ func (g *Game) tryDropItem(e *entity.Enemy) {
 		return
 	}
 	var typ entity.ItemType
-	if roll < 25 {
+	switch {
+	case roll < 25:
 		typ = entity.ItemHeart
-	} else {
+	case roll < 35 && g.Player.Inventory.OwnedItems[entity.EquipBow]:
+		// Arrows come out of the rupee share, leaving hearts as they were
+		typ = entity.ItemArrows
+	default:
 		typ = entity.ItemRupee
 	}
 	item := entity.NewItem(typ, e.X, e.Y)
@@ -910,55 +1307,123 @@ func (g *Game) damagePlayer(amount int) {
 }
 
 func (g *Game) checkEnemyCollisions() {
-	if g.Player.InvTimer > 0 {
-		return
-	}
 	for _, e := range g.Enemies {
 		if e.Dead {
 			continue
 		}
-		if system.CheckEnemyPlayerCollision(g.Player, e) {
-			g.damagePlayer(1)
+		if !system.CheckEnemyPlayerCollision(g.Player, e) {
+			continue
+		}
+		// Jumping clears ground enemies
+		if g.Player.Jumping && !system.EnemyFlies(e.Type) {
+			continue
+		}
+		// A raised shield pushes enemies back instead of taking the hit
+		if system.ShieldFacesPoint(g.Player, e.CenterX(), e.CenterY()) {
+			if e.KnockbackTimer <= 0 {
+				system.ApplyKnockback(e, g.Player.CenterX(), g.Player.CenterY())
+				g.Audio.PlayShieldBlock()
+			}
+			continue
+		}
+		if g.Player.InvTimer > 0 {
 			return
 		}
+		g.damagePlayer(1)
+		return
 	}
 }
 
 func (g *Game) checkProjectileCollisions() {
-	if g.Player.InvTimer > 0 {
+	// Projectiles fly over a jumping or diving player
+	if g.Player.Jumping || g.Player.Diving {
 		return
 	}
 	for _, proj := range g.Projectiles {
 		if proj.Dead || !proj.FromEnemy {
 			continue
 		}
-		if system.CheckProjectilePlayerCollision(g.Player, proj) {
-			proj.Dead = true
-			g.damagePlayer(proj.Damage)
+		if !system.CheckProjectilePlayerCollision(g.Player, proj) {
+			continue
+		}
+		// The mirror shield sends projectiles back; the basic shield stops them
+		if system.ShieldBlocksProjectile(g.Player, proj) {
+			if g.Player.Inventory.ShieldLevel >= 2 {
+				system.ReflectProjectile(proj)
+			} else {
+				proj.Dead = true
+			}
+			g.Audio.PlayShieldBlock()
+			continue
+		}
+		if g.Player.InvTimer > 0 {
 			return
 		}
+		proj.Dead = true
+		g.damagePlayer(proj.Damage)
+		return
 	}
 }
 
+// checkPlayerProjectileHits damages enemies struck by the player's
+// projectiles. Returns true if a hit ended the game.
+func (g *Game) checkPlayerProjectileHits() bool {
+	for _, proj := range g.Projectiles {
+		if proj.Dead || proj.FromEnemy || proj.Stuck {
+			continue
+		}
+		e := system.CheckProjectileEnemyHits(proj, g.Enemies)
+		if e == nil {
+			continue
+		}
+		proj.Dead = true
+		if proj.Kind == entity.ProjectileBombArrow {
+			if g.explode(proj.CenterX(), proj.CenterY()) {
+				return true
+			}
+			continue
+		}
+		damage := proj.Damage
+		if proj.Kind == entity.ProjectileFireball && system.EnemyIsIcy(e.Type) {
+			damage = e.HP
+		}
+		if g.hitEnemy(e, damage, proj.X, proj.Y) {
+			return true
+		}
+	}
+	return false
+}
+
 // getActiveDialogue returns the appropriate dialogue lines for an NPC,
-// checking conditional dialogues first, then falling back to default.
-func (g *Game) getActiveDialogue(npc *entity.NPC) []string {
+// checking conditional dialogues first, then falling back to default. It
+// also returns the quest flag the chosen lines set, if any.
+func (g *Game) getActiveDialogue(npc *entity.NPC) ([]string, string) {
 	for _, d := range npc.Dialogues {
 		if CheckCondition(d.Condition, g.Quest, &g.Player.Inventory) {
-			return d.Lines
+			return d.Lines, d.SetFlag
 		}
 	}
-	return npc.Dialogue
+	return npc.Dialogue, ""
 }
 
 func (g *Game) tryInteractNPC() bool {
 	for _, npc := range g.NPCs {
 		if system.ProximityCheck(g.Player.CenterX(), g.Player.CenterY(),
 			npc.CenterX(), npc.CenterY(), config.InteractRadius) {
+			if npc.Asleep {
+				g.Dialogue.StartWithLines(npc, []string{"Zzz... Zzz..."})
+				g.State = StateDialogue
+				return true
+			}
+
 			// Use conditional dialogue
-			activeLines := g.getActiveDialogue(npc)
+			activeLines, setFlag := g.getActiveDialogue(npc)
 			g.Dialogue.StartWithLines(npc, activeLines)
 			g.State = StateDialogue
+			if setFlag != "" {
+				g.Quest.SetFlag(setFlag)
+				g.learnSongs()
+			}
 
 			// Set quest flags based on NPC ID
 			switch npc.ID {
@@ -979,6 +1444,9 @@ func (g *Game) tryInteractNPC() bool {
 }
 
 func (g *Game) tryInteractDoor() bool {
+	if g.Location == LocationDungeon {
+		return g.tryInteractDungeonDoor()
+	}
 	if g.InInterior {
 		return false
 	}
@@ -1009,6 +1477,10 @@ func (g *Game) checkDoorEntry() {
 		return
 	}
 
+	if g.Location == LocationDungeon {
+		return
+	}
+
 	px := int(g.Player.CenterX()) / config.TileSize
 	py := int(g.Player.CenterY()) / config.TileSize
 
@@ -1066,6 +1538,17 @@ func (g *Game) checkDoorEntry() {
 		dl := &g.DoorLinks[i]
 		if dl.ScreenX == g.Overworld.CurrentX && dl.ScreenY == g.Overworld.CurrentY &&
 			dl.DoorTileX == px && dl.DoorTileY == py {
+			if dl.DungeonID != "" {
+				dungeon := g.loadDungeon(dl.DungeonID)
+				if dungeon == nil {
+					continue
+				}
+				g.PendingDungeon = dungeon
+				g.PendingDoorLink = dl
+				g.Transition.StartFade()
+				g.Audio.PlayDoorOpen()
+				return
+			}
 			interior, ok := g.Interiors[dl.InteriorID]
 			if !ok {
 				continue
@@ -1091,10 +1574,20 @@ func (g *Game) completeFadeTransition() {
 		g.PendingDoorLink = nil
 		g.spawnScreenEntities()
 		g.SaveGame()
+	} else if g.PendingDungeon != nil {
+		g.enterDungeon(g.PendingDungeon, g.PendingDoorLink)
+		g.PendingDungeon = nil
+		g.PendingDoorLink = nil
+	} else if g.PendingPit != nil {
+		g.dropThroughPit(g.PendingPit)
+		g.PendingPit = nil
 	} else if g.PendingExitLink != nil {
 		g.InInterior = false
 		g.Location = LocationOverworld
 		g.CurrentInterior = nil
+		g.CurrentDungeon = nil
+		g.Overworld.CurrentX = g.PendingExitLink.ScreenX
+		g.Overworld.CurrentY = g.PendingExitLink.ScreenY
 		g.Player.X = g.PendingExitLink.ExitX
 		g.Player.Y = g.PendingExitLink.ExitY
 		g.PendingExitLink = nil
@@ -1109,6 +1602,8 @@ func (g *Game) spawnDeathParticles(e *entity.Enemy) {
 	switch e.Type {
 	case entity.EnemyOctorok:
 		r, g2, b = 200, 50, 50
+	case entity.EnemyIceOctorok:
+		r, g2, b = 150, 200, 240
 	case entity.EnemyMoblin:
 		r, g2, b = 160, 100, 50
 	case entity.EnemyStalfos:
@@ -1159,25 +1654,31 @@ func (g *Game) Draw(canvas *glow.Canvas) {
 			render.DrawTransition(
 				sc,
 				g.Transition.OldScreen,
-				g.Overworld.CurrentScreen(),
+				g.currentScreen(),
 				g.Player,
 				g.Transition.DirX,
 				g.Transition.DirY,
 				progress,
+				g.AnimTime,
 			)
 		case TransitionFade:
 			screen := g.currentScreen()
-			render.DrawScreenAt(sc, screen, shakeX, shakeY)
+			render.DrawScreenAt(sc, screen, shakeX, shakeY, g.AnimTime)
 			g.drawEntities(sc, shakeX, shakeY)
 			render.DrawPlayerAt(sc, g.Player, shakeX, shakeY)
+			g.drawAbovePlayer(sc, shakeX, shakeY)
 			render.DrawParticles(sc, g.Particles.Particles, shakeX, shakeY)
 			render.DrawFade(sc, g.Transition.FadeProgress())
 		}
 	} else {
 		screen := g.currentScreen()
-		render.DrawScreenAt(sc, screen, shakeX, shakeY)
+		render.DrawScreenAt(sc, screen, shakeX, shakeY, g.AnimTime)
 		g.drawEntities(sc, shakeX, shakeY)
 		render.DrawPlayerAt(sc, g.Player, shakeX, shakeY)
+		if g.Player.ItemGet {
+			render.DrawHeldItemAt(sc, g.Player, g.HeldItem, shakeX, shakeY)
+		}
+		g.drawAbovePlayer(sc, shakeX, shakeY)
 		render.DrawParticles(sc, g.Particles.Particles, shakeX, shakeY)
 	}
 
@@ -1190,11 +1691,15 @@ func (g *Game) Draw(canvas *glow.Canvas) {
 		render.DrawFlash(sc, intensity)
 	}
 
-	render.DrawHUD(sc, g.Player)
+	keys := g.Player.Inventory.Keys
+	if g.Location == LocationDungeon && g.CurrentDungeon != nil {
+		keys = g.CurrentDungeon.SmallKeys
+	}
+	render.DrawHUD(sc, g.Player, keys)
 
 	// Dialogue box on top
 	if g.State == StateDialogue && g.Dialogue.Active {
-		render.DrawDialogueBox(sc, g.Dialogue.NPC.Name, g.Dialogue.Lines,
+		render.DrawDialogueBox(sc, g.Dialogue.Name, g.Dialogue.Lines,
 			g.Dialogue.CurrentLine, g.Dialogue.HasMore())
 	}
 
@@ -1207,6 +1712,16 @@ func (g *Game) Draw(canvas *glow.Canvas) {
 	if g.State == StateInventory {
 		render.DrawInventoryScreen(sc, &g.Player.Inventory, g.InventoryCursorX, g.InventoryCursorY)
 	}
+
+	// Dungeon map overlay
+	if g.State == StateDungeonMap && g.CurrentDungeon != nil {
+		render.DrawDungeonMapScreen(sc, g.CurrentDungeon)
+	}
+
+	// Ocarina song picker
+	if g.State == StateSongSelect {
+		render.DrawSongSelect(sc, g.Player.Inventory.Songs, g.SongCursor)
+	}
 }
 
 func (g *Game) drawBossHealthBar(sc *render.ScaledCanvas) {
@@ -1222,6 +1737,19 @@ func (g *Game) drawEntities(sc *render.ScaledCanvas, offsetX, offsetY int) {
 	for _, item := range g.Items {
 		render.DrawItemAt(sc, item, offsetX, offsetY)
 	}
+	for _, b := range g.Bombs {
+		if !b.Carried {
+			render.DrawBombAt(sc, b, offsetX, offsetY)
+		}
+	}
+	for _, l := range g.Lifted {
+		if !l.Carried {
+			render.DrawLiftedAt(sc, l, offsetX, offsetY)
+		}
+	}
+	for _, b := range g.Blocks {
+		render.DrawPushBlockAt(sc, b.PushBlock, offsetX, offsetY)
+	}
 	for _, e := range g.Enemies {
 		render.DrawEnemyAt(sc, e, offsetX, offsetY)
 	}
@@ -1232,3 +1760,26 @@ func (g *Game) drawEntities(sc *render.ScaledCanvas, offsetX, offsetY int) {
 		render.DrawNPCAt(sc, npc, offsetX, offsetY)
 	}
 }
+
+// drawAbovePlayer draws whatever the player carries overhead and blasts,
+// which cover the player sprite.
+func (g *Game) drawAbovePlayer(sc *render.ScaledCanvas, offsetX, offsetY int) {
+	if g.CarriedBomb != nil {
+		render.DrawBombAt(sc, g.CarriedBomb, offsetX, offsetY)
+	}
+	if g.CarriedObject != nil {
+		render.DrawLiftedAt(sc, g.CarriedObject, offsetX, offsetY)
+	}
+	if g.Hookshot != nil {
+		render.DrawHookshotAt(sc, g.Hookshot, offsetX, offsetY)
+	}
+	if g.Boomerang != nil {
+		render.DrawBoomerangAt(sc, g.Boomerang, offsetX, offsetY)
+	}
+	if g.State == StateSongPlaying {
+		render.DrawSongNotes(sc, g.Player, g.SongTimer, offsetX, offsetY)
+	}
+	for _, e := range g.Explosions {
+		render.DrawExplosionAt(sc, e, offsetX, offsetY)
+	}
+}
@@ -0,0 +1,148 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// checkDamagingTiles hurts a player standing on spikes or other damaging
+// ground and knocks them back off it. Returns true if the hit ended the
+// game.
+func (g *Game) checkDamagingTiles() bool {
+	p := g.Player
+	if p.Jumping || p.Falling || p.InvTimer > 0 {
+		return false
+	}
+	ts := config.TileSize
+	tx := int(p.CenterX()) / ts
+	ty := int(p.CenterY()) / ts
+	if !world.TileProps[g.currentScreen().TileAt(tx, ty)].Damaging {
+		return false
+	}
+
+	g.damagePlayer(1)
+	if g.State == StateGameOver {
+		return true
+	}
+	system.KnockbackPlayer(p, float64(tx*ts+ts/2), float64(ty*ts+ts/2))
+	return false
+}
+
+// checkFall starts the player falling once their centre is over a pit or
+// lava. A hookshot pull carries them safely across.
+func (g *Game) checkFall() {
+	p := g.Player
+	if p.Jumping || p.Falling || (g.Hookshot != nil && g.Hookshot.State == entity.HookPulling) {
+		return
+	}
+	tx := int(p.CenterX()) / config.TileSize
+	ty := int(p.CenterY()) / config.TileSize
+	if !world.TileProps[g.currentScreen().TileAt(tx, ty)].Fall {
+		return
+	}
+
+	g.throwCarried()
+	g.Hookshot = nil
+	p.StartFall(tx, ty)
+	g.Audio.PlayFall()
+}
+
+// updateFall plays out the falling animation, then drops the player through
+// the pit's warp or puts them back at the room entrance, hurt. Lava hurts
+// more than a pit.
+func (g *Game) updateFall(dt float64) {
+	p := g.Player
+	p.FallTimer += dt
+	if p.FallTimer < config.FallTime {
+		return
+	}
+	p.Falling = false
+	p.FallTimer = 0
+
+	screen := g.currentScreen()
+	tx := int(p.CenterX()) / config.TileSize
+	ty := int(p.CenterY()) / config.TileSize
+	if pit := screen.PitAt(tx, ty); pit != nil && g.pitLeadsSomewhere(pit) {
+		g.PendingPit = pit
+		g.Transition.StartFade()
+		return
+	}
+
+	damage := config.PitDamage
+	if screen.TileAt(tx, ty) == world.TileLava {
+		damage = config.LavaDamage
+	}
+	p.X = g.EntryX
+	p.Y = g.EntryY
+	g.damagePlayer(damage)
+}
+
+// pitLeadsSomewhere returns true if a pit warp's target exists from here:
+// dungeon rooms from inside the dungeon, interiors from anywhere else.
+func (g *Game) pitLeadsSomewhere(pit *world.PitWarp) bool {
+	if g.pitRoom(pit) != nil {
+		return true
+	}
+	return g.pitInterior(pit) != nil
+}
+
+// pitInterior returns the interior an "interior:ID" pit warp leads to.
+// Dungeons have no interiors below them.
+func (g *Game) pitInterior(pit *world.PitWarp) *world.InteriorDef {
+	id, ok := strings.CutPrefix(pit.Target, "interior:")
+	if !ok || g.Location == LocationDungeon {
+		return nil
+	}
+	return g.Interiors[id]
+}
+
+// pitRoom returns the dungeon room a "room:X,Y" pit warp leads to.
+func (g *Game) pitRoom(pit *world.PitWarp) *world.DungeonRoom {
+	if g.Location != LocationDungeon || g.CurrentDungeon == nil {
+		return nil
+	}
+	var rx, ry int
+	if _, err := fmt.Sscanf(pit.Target, "room:%d,%d", &rx, &ry); err != nil {
+		return nil
+	}
+	return g.CurrentDungeon.RoomAt(rx, ry)
+}
+
+// dropThroughPit lands the player on the floor below a pit warp.
+func (g *Game) dropThroughPit(pit *world.PitWarp) {
+	if room := g.pitRoom(pit); room != nil {
+		d := g.CurrentDungeon
+		d.CurrentRoom = [2]int{room.X, room.Y}
+		d.VisitedRooms[d.CurrentRoom] = true
+	} else if interior := g.pitInterior(pit); interior != nil {
+		// Leaving the lower floor goes back out to the overworld: through
+		// the door already in use, or to where the player entered this screen
+		link := &world.DoorLink{
+			ScreenX:    g.Overworld.CurrentX,
+			ScreenY:    g.Overworld.CurrentY,
+			InteriorID: interior.ID,
+			ExitX:      g.EntryX,
+			ExitY:      g.EntryY,
+		}
+		if g.InInterior && g.ReturnLink != nil {
+			link.ScreenX = g.ReturnLink.ScreenX
+			link.ScreenY = g.ReturnLink.ScreenY
+			link.ExitX = g.ReturnLink.ExitX
+			link.ExitY = g.ReturnLink.ExitY
+		}
+		g.InInterior = true
+		g.Location = LocationInterior
+		g.CurrentInterior = interior
+		g.ReturnLink = link
+	}
+
+	g.Player.X = pit.SpawnX
+	g.Player.Y = pit.SpawnY
+	g.spawnScreenEntities()
+	g.SaveGame()
+}
@@ -0,0 +1,144 @@
+package game
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// fireHookshot sends the chain out in the facing direction.
+func (g *Game) fireHookshot() {
+	if g.Hookshot != nil {
+		return
+	}
+	g.Hookshot = entity.NewHookshot(g.Player)
+	g.Audio.PlayHookshot()
+}
+
+// updateHookshot moves the chain out, back, or pulls the player along it.
+// The player can't walk while it is out.
+func (g *Game) updateHookshot(dt float64) {
+	h := g.Hookshot
+	p := g.Player
+	p.Dir = h.Dir
+	p.Moving = false
+	h.OriginX = p.CenterX()
+	h.OriginY = p.CenterY()
+
+	step := config.HookshotSpeed * dt
+	dx, dy := h.Dir.DX(), h.Dir.DY()
+
+	switch h.State {
+	case entity.HookExtending:
+		h.TipX += dx * step
+		h.TipY += dy * step
+		g.catchWithHookshot(h)
+
+	case entity.HookRetracting:
+		h.TipX -= dx * step
+		h.TipY -= dy * step
+
+		// A caught enemy is let go a tile short of the player
+		reach := 0.0
+		if h.Enemy != nil {
+			reach = config.TileSize
+		}
+		if h.Length() <= reach {
+			h.TipX = h.OriginX + dx*reach
+			h.TipY = h.OriginY + dy*reach
+		}
+		if h.Item != nil {
+			h.Item.X = h.TipX - float64(h.Item.Width)/2
+			h.Item.Y = h.TipY - float64(h.Item.Height)/2
+		}
+		if h.Enemy != nil {
+			h.Enemy.X = h.TipX - float64(h.Enemy.Width)/2
+			h.Enemy.Y = h.TipY - float64(h.Enemy.Height)/2
+		}
+		if h.Length() <= reach {
+			g.Hookshot = nil
+		}
+
+	case entity.HookPulling:
+		// Stop once the player is up against what the hook latched onto
+		stop := float64(config.TileSize+p.Width) / 2
+		move := h.Length() - stop
+		if move > step {
+			move = step
+		}
+		// Anything the player's body can't pass, such as a wall corner
+		// beside the chain or water without Flippers, ends the pull early
+		nx, ny := p.X+dx*move, p.Y+dy*move
+		if system.PlayerBlocked(p, g.currentScreen(), nx, ny, dx, dy) {
+			g.Hookshot = nil
+			return
+		}
+		p.X, p.Y = nx, ny
+		h.OriginX = p.CenterX()
+		h.OriginY = p.CenterY()
+		if h.Length() <= stop {
+			g.Hookshot = nil
+		}
+	}
+}
+
+// catchWithHookshot checks what the extending tip has reached: enemies are
+// stunned and small ones pulled in, items are dragged back, hookable tiles
+// pull the player over and plain walls stop the chain.
+func (g *Game) catchWithHookshot(h *entity.Hookshot) {
+	tipX, tipY := h.TipX-2, h.TipY-2
+
+	for _, e := range g.Enemies {
+		if e.Dead {
+			continue
+		}
+		if system.AABBOverlap(tipX, tipY, 4, 4, e.X, e.Y, float64(e.Width), float64(e.Height)) {
+			e.StunTimer = config.HookshotStun
+			if e.Type != entity.EnemyBoss {
+				h.Enemy = e
+			}
+			h.State = entity.HookRetracting
+			g.Audio.PlayEnemyHit()
+			return
+		}
+	}
+
+	for _, item := range g.Items {
+		if item.Collected || item.Hidden {
+			continue
+		}
+		if system.AABBOverlap(tipX, tipY, 4, 4, item.X, item.Y, float64(item.Width), float64(item.Height)) {
+			h.Item = item
+			h.State = entity.HookRetracting
+			return
+		}
+	}
+
+	if h.TipX < 0 || h.TipY < 0 || h.TipX >= config.PlayAreaWidth || h.TipY >= config.PlayAreaHeight {
+		h.State = entity.HookRetracting
+		return
+	}
+
+	ts := config.TileSize
+	tx, ty := int(h.TipX)/ts, int(h.TipY)/ts
+	tile := g.currentScreen().TileAt(tx, ty)
+	props := world.TileProps[tile]
+	switch {
+	case props.Hookable:
+		// Latch onto the middle of the tile
+		h.TipX = float64(tx*ts + ts/2)
+		h.TipY = float64(ty*ts + ts/2)
+		h.State = entity.HookPulling
+		g.Audio.PlayShieldBlock()
+		return
+	case !props.Passable && !props.Swimmable && !props.Damaging && tile != world.TilePit:
+		h.State = entity.HookRetracting
+		g.Audio.PlayBonk()
+		return
+	}
+
+	if h.Length() >= config.HookshotRange {
+		h.State = entity.HookRetracting
+	}
+}
@@ -0,0 +1,224 @@
+package game
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+	"github.com/AchrafSoltani/glow"
+)
+
+// buttonHeld returns true if the A or B button assigned to an item is held.
+func (g *Game) buttonHeld(item entity.EquipItemID) bool {
+	inv := &g.Player.Inventory
+	return (g.Input.IsHeld(glow.KeyJ) && inv.ButtonA == item) ||
+		(g.Input.IsHeld(glow.KeyK) && inv.ButtonB == item)
+}
+
+// comboPressed returns true when the two items are on A and B and one
+// button is pressed while the other is pressed or held.
+func (g *Game) comboPressed(a, b entity.EquipItemID) bool {
+	inv := &g.Player.Inventory
+	if !(inv.ButtonA == a && inv.ButtonB == b) && !(inv.ButtonA == b && inv.ButtonB == a) {
+		return false
+	}
+	return (g.Input.JustPressed(glow.KeyJ) && g.Input.IsHeld(glow.KeyK)) ||
+		(g.Input.JustPressed(glow.KeyK) && g.Input.IsHeld(glow.KeyJ))
+}
+
+// fireArrow shoots an arrow along the player's facing, spending ammo.
+func (g *Game) fireArrow(bomb bool) {
+	p := g.Player
+	p.Inventory.Arrows--
+	x := p.CenterX() + p.Dir.DX()*float64(p.Width)/2
+	y := p.CenterY() + p.Dir.DY()*float64(p.Height)/2
+	g.Projectiles = append(g.Projectiles, entity.NewArrow(x, y, p.Dir, bomb))
+	g.Audio.PlaySwordSwing()
+}
+
+// castFireball sends a Magic Rod fireball out in the facing direction.
+func (g *Game) castFireball() {
+	count := 0
+	for _, proj := range g.Projectiles {
+		if proj.Kind == entity.ProjectileFireball && !proj.Dead {
+			count++
+		}
+	}
+	if count >= config.MaxFireballs {
+		return
+	}
+	p := g.Player
+	x := p.CenterX() + p.Dir.DX()*float64(p.Width)/2
+	y := p.CenterY() + p.Dir.DY()*float64(p.Height)/2
+	g.Projectiles = append(g.Projectiles, entity.NewFireball(x, y, p.Dir))
+	g.Audio.PlayFireball()
+}
+
+// burnTiles lights the torches and burns away the bushes a fireball touches.
+// Returns true if it reached either.
+func (g *Game) burnTiles(p *entity.Projectile) bool {
+	screen := g.currentScreen()
+	ts := config.TileSize
+	burned := false
+	for ty := int(p.Y) / ts; ty <= int(p.Y+float64(p.Height)-1)/ts; ty++ {
+		for tx := int(p.X) / ts; tx <= int(p.X+float64(p.Width)-1)/ts; tx++ {
+			tile := screen.TileAt(tx, ty)
+			switch {
+			case tile == world.TileTorch:
+				screen.Tiles[ty][tx] = world.TileTorchLit
+				burned = true
+			case world.TileProps[tile].Cuttable:
+				screen.CutTile(tx, ty)
+				g.revealItemsAt(tx, ty)
+				g.spawnTileParticles(tx, ty, 255, 140, 40)
+				burned = true
+			}
+		}
+	}
+	return burned
+}
+
+// fireBombArrow fires an arrow carrying a bomb, if there's one of each.
+func (g *Game) fireBombArrow() {
+	inv := &g.Player.Inventory
+	if inv.Arrows <= 0 || inv.Bombs <= 0 || g.Player.Lifting || g.Player.Swimming {
+		return
+	}
+	inv.Bombs--
+	g.fireArrow(true)
+}
+
+// arrowHitSwitch presses a switch an arrow flies over. Returns true if it
+// hit one.
+func (g *Game) arrowHitSwitch(p *entity.Projectile) bool {
+	tx := int(p.CenterX()) / config.TileSize
+	ty := int(p.CenterY()) / config.TileSize
+	screen := g.currentScreen()
+	if screen.TileAt(tx, ty) != world.TileSwitchOff {
+		return false
+	}
+	g.pressSwitch(screen, tx, ty)
+	return true
+}
+
+// pressSwitch flips a floor switch on. A dungeon room's switches also set
+// off their targets; switches that need weight on them ignore a hit.
+func (g *Game) pressSwitch(screen *world.Screen, tx, ty int) {
+	if room := g.switchRoom(); room != nil {
+		if sw := room.SwitchAt(tx, ty); sw != nil {
+			if sw.Mode != world.SwitchHold {
+				sw.Pressed = true
+				g.applySwitches(room)
+			}
+			return
+		}
+	}
+	screen.Tiles[ty][tx] = world.TileSwitchOn
+	g.Audio.PlayMenuSelect()
+}
+
+// updateDashCharge builds up a boots dash while the button is held and
+// cancels it when released.
+func (g *Game) updateDashCharge(dt float64) {
+	p := g.Player
+	if !p.DashCharging && !p.Dashing {
+		return
+	}
+	if !g.buttonHeld(entity.EquipPegasusBoots) {
+		p.StopDash()
+		return
+	}
+	if p.DashCharging {
+		// Run in place while charging
+		p.Moving = true
+		p.UpdateAnimation(dt)
+		if p.ChargeDash(dt) {
+			g.Audio.PlayDash()
+		}
+	}
+}
+
+// updateDash moves a dashing player, breaking bushes and striking enemies
+// with the held sword. Hitting a wall ends the dash with a bounce and a
+// screen shake. Returns true if a hit ended the game.
+func (g *Game) updateDash(dt float64) bool {
+	p := g.Player
+	p.Dir = p.DashDir
+	p.Moving = true
+
+	crossX, crossY, broken, hitWall := system.DashPlayer(p, g.currentScreen(), dt)
+	g.cutBushes(broken)
+
+	for _, e := range system.CheckDashHits(p, g.Enemies) {
+		if g.hitEnemy(e, 1, p.CenterX(), p.CenterY()) {
+			return true
+		}
+	}
+
+	if hitWall && !p.Jumping {
+		p.StopDash()
+		g.ShakeTimer = config.ShakeDuration
+		g.Audio.PlayBonk()
+		bx := p.X - p.DashDir.DX()*config.DashRecoil
+		by := p.Y - p.DashDir.DY()*config.DashRecoil
+		if !system.TileCollision(g.currentScreen(), bx, by, p.Width, p.Height) {
+			p.X = bx
+			p.Y = by
+		}
+		return false
+	}
+
+	g.handlePlayerCrossing(crossX, crossY)
+	return false
+}
+
+// spawnTileParticles bursts debris out of a destroyed tile.
+func (g *Game) spawnTileParticles(tx, ty int, r, gr, b uint8) {
+	velocities := make([]float64, 12)
+	for i := range velocities {
+		velocities[i] = float64(int32(g.RNG.Next()%200)-100) / 2.0
+	}
+	cx := float64(tx*config.TileSize + config.TileSize/2)
+	cy := float64(ty*config.TileSize + config.TileSize/2)
+	g.Particles.SpawnExplosion(cx, cy, 6, r, gr, b, velocities)
+}
+
+// cutBushes scatters leaves from freshly cut tiles, uncovers anything hidden
+// under them and rolls each one's drop table.
+func (g *Game) cutBushes(tiles [][2]int) {
+	for _, t := range tiles {
+		g.spawnTileParticles(t[0], t[1], 60, 160, 60)
+		g.revealItemsAt(t[0], t[1])
+		if typ, ok := g.rollBushDrop(); ok {
+			item := entity.NewItem(typ,
+				float64(t[0]*config.TileSize)+2,
+				float64(t[1]*config.TileSize)+2)
+			g.Items = append(g.Items, item)
+		}
+	}
+	if len(tiles) > 0 {
+		g.Audio.PlayEnemyHit()
+	}
+}
+
+// rollBushDrop picks what a cut bush leaves behind. Arrows and bombs only
+// turn up once the player owns the bow or bombs.
+func (g *Game) rollBushDrop() (entity.ItemType, bool) {
+	roll := g.RNG.Next() % 100
+	if roll >= config.BushDropChance {
+		return 0, false
+	}
+	owned := g.Player.Inventory.OwnedItems
+	switch {
+	case roll < 4 && owned[entity.EquipBow]:
+		return entity.ItemArrows, true
+	case roll < 8 && owned[entity.EquipBomb]:
+		return entity.ItemBombs, true
+	case roll < 11 && owned[entity.EquipMagicPowder]:
+		return entity.ItemPowder, true
+	case roll < 14:
+		return entity.ItemHeart, true
+	default:
+		return entity.ItemRupee, true
+	}
+}
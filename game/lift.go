@@ -0,0 +1,136 @@
+package game
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// tryLiftTile pulls up the rock or pot the player is facing, if the Power
+// Bracelet is strong enough, and reveals anything hidden under it.
+func (g *Game) tryLiftTile() bool {
+	p := g.Player
+	if p.Lifting || p.Swimming || p.Inventory.BraceletLevel == 0 {
+		return false
+	}
+
+	screen := g.currentScreen()
+	tx, ty := g.facingTile()
+	tile := screen.TileAt(tx, ty)
+	level := world.TileProps[tile].Liftable
+	if level == 0 || level > p.Inventory.BraceletLevel {
+		return false
+	}
+
+	kind := entity.LiftedRock
+	switch tile {
+	case world.TileHeavyRock:
+		kind = entity.LiftedHeavyRock
+	case world.TilePot:
+		kind = entity.LiftedPot
+	}
+
+	screen.LiftTile(tx, ty, g.groundTile())
+	g.revealItemsAt(tx, ty)
+
+	obj := entity.NewLifted(kind, p.CenterX()-7, p.CenterY()-7)
+	g.Lifted = append(g.Lifted, obj)
+	g.CarriedObject = obj
+	p.Lifting = true
+	g.Audio.PlayMenuSelect()
+	return true
+}
+
+// facingTile returns the grid position in front of the player.
+func (g *Game) facingTile() (int, int) {
+	px := int(g.Player.CenterX()) / config.TileSize
+	py := int(g.Player.CenterY()) / config.TileSize
+	return px + int(g.Player.Dir.DX()), py + int(g.Player.Dir.DY())
+}
+
+// groundTile returns the bare tile left behind when something is removed
+// from the current screen.
+func (g *Game) groundTile() world.TileType {
+	if g.Location == LocationOverworld && !g.InInterior {
+		return world.TileGrass
+	}
+	return world.TileFloor
+}
+
+// revealItemsAt uncovers hidden items lying on a grid position.
+func (g *Game) revealItemsAt(tx, ty int) {
+	for _, item := range g.Items {
+		if !item.Hidden {
+			continue
+		}
+		if int(item.X)/config.TileSize == tx && int(item.Y)/config.TileSize == ty {
+			item.Hidden = false
+		}
+	}
+}
+
+// updateLifted keeps the carried object over the player's head and flies
+// thrown ones until they land, hit a wall or hit an enemy. Returns true if
+// a hit ended the game.
+func (g *Game) updateLifted(dt float64) bool {
+	screen := g.currentScreen()
+	alive := g.Lifted[:0]
+	for _, l := range g.Lifted {
+		if l.Carried {
+			l.X = g.Player.CenterX() - float64(l.Width)/2
+			l.Y = g.Player.CenterY() - float64(l.Height)/2
+			alive = append(alive, l)
+			continue
+		}
+
+		nx := l.X + l.VX*dt
+		ny := l.Y + l.VY*dt
+		if system.TileCollision(screen, nx, ny, l.Width, l.Height) ||
+			nx < 0 || ny < 0 || nx+float64(l.Width) > config.PlayAreaWidth ||
+			ny+float64(l.Height) > config.PlayAreaHeight {
+			g.shatterLifted(l)
+			continue
+		}
+		l.X = nx
+		l.Y = ny
+
+		for _, e := range g.Enemies {
+			if e.Dead || e.InvTimer > 0 {
+				continue
+			}
+			if system.AABBOverlap(l.X, l.Y, float64(l.Width), float64(l.Height),
+				e.X, e.Y, float64(e.Width), float64(e.Height)) {
+				g.shatterLifted(l)
+				if g.hitEnemy(e, config.ThrowDamage, l.CenterX(), l.CenterY()) {
+					return true
+				}
+				break
+			}
+		}
+		if l.Dead {
+			continue
+		}
+
+		if l.UpdateArc(dt) {
+			g.shatterLifted(l)
+			continue
+		}
+		alive = append(alive, l)
+	}
+	g.Lifted = alive
+	return false
+}
+
+// shatterLifted breaks a thrown object into debris.
+func (g *Game) shatterLifted(l *entity.Lifted) {
+	l.Dead = true
+	tx := int(l.CenterX()) / config.TileSize
+	ty := int(l.CenterY()) / config.TileSize
+	if l.Kind == entity.LiftedPot {
+		g.spawnTileParticles(tx, ty, 170, 120, 60)
+	} else {
+		g.spawnTileParticles(tx, ty, 140, 130, 120)
+	}
+	g.Audio.PlayBonk()
+}
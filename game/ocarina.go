@@ -0,0 +1,209 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/AchrafSoltani/GlowQuest/audio"
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/world"
+	"github.com/AchrafSoltani/glow"
+)
+
+// songFlags are the quest flags that teach each song, indexed by SongID.
+// NPC dialogue options set them through "set_flag".
+var songFlags = [3]string{"song_ballad", "song_mambo", "song_soul"}
+
+// learnSongs marks every song whose flag has been set as learned.
+func (g *Game) learnSongs() {
+	for i, flag := range songFlags {
+		if g.Quest.HasFlag(flag) {
+			g.Player.Inventory.Songs[i] = true
+		}
+	}
+}
+
+// openSongPicker brings up the list of learned songs.
+func (g *Game) openSongPicker() {
+	inv := &g.Player.Inventory
+	for i, known := range inv.Songs {
+		if known {
+			g.SongCursor = i
+			g.State = StateSongSelect
+			g.Audio.PlayMenuSelect()
+			return
+		}
+	}
+	g.Dialogue.StartMessage("", []string{"You don't know any", "songs yet."})
+	g.State = StateDialogue
+}
+
+// updateSongSelect moves the cursor over learned songs and plays the chosen
+// one.
+func (g *Game) updateSongSelect() {
+	inv := &g.Player.Inventory
+	if g.Input.JustPressed(glow.KeyEscape) || g.Input.JustPressed(glow.KeyTab) {
+		g.State = StatePlaying
+		return
+	}
+	if g.Input.JustPressed(glow.KeyUp) || g.Input.JustPressed(glow.KeyW) {
+		for i := g.SongCursor - 1; i >= 0; i-- {
+			if inv.Songs[i] {
+				g.SongCursor = i
+				g.Audio.PlayMenuSelect()
+				break
+			}
+		}
+	}
+	if g.Input.JustPressed(glow.KeyDown) || g.Input.JustPressed(glow.KeyS) {
+		for i := g.SongCursor + 1; i < len(inv.Songs); i++ {
+			if inv.Songs[i] {
+				g.SongCursor = i
+				g.Audio.PlayMenuSelect()
+				break
+			}
+		}
+	}
+	if g.Input.JustPressed(glow.KeySpace) || g.Input.JustPressed(glow.KeyJ) {
+		g.SongPlaying = entity.SongID(g.SongCursor)
+		g.SongTimer = audio.SongDuration(g.SongCursor)
+		g.Audio.PlaySong(g.SongCursor)
+		g.State = StateSongPlaying
+	}
+}
+
+// updateSongPlaying holds the player still until the melody ends, then
+// applies the song's effect.
+func (g *Game) updateSongPlaying(dt float64) {
+	g.SongTimer -= dt
+	if g.SongTimer > 0 {
+		return
+	}
+	g.SongTimer = 0
+	g.State = StatePlaying
+	g.performSong(g.SongPlaying)
+}
+
+// performSong applies a song's effect to the world.
+func (g *Game) performSong(song entity.SongID) {
+	switch song {
+	case entity.SongBallad:
+		g.openSongDoors()
+	case entity.SongMambo:
+		g.warpWithMambo()
+	case entity.SongSoul:
+		g.playSongOfSoul()
+	}
+}
+
+// openSongDoors opens every song door on the current screen.
+func (g *Game) openSongDoors() {
+	screen := g.currentScreen()
+	opened := false
+	for ty := 0; ty < config.ScreenGridH; ty++ {
+		for tx := 0; tx < config.ScreenGridW; tx++ {
+			if screen.Tiles[ty][tx] != world.TileSongDoor {
+				continue
+			}
+			screen.Tiles[ty][tx] = world.TileDoorOpen
+			g.SongDoors[fmt.Sprintf("%s_%d,%d", g.screenKey(), tx, ty)] = true
+			opened = true
+		}
+	}
+	if opened {
+		g.Audio.PlayDoorOpen()
+		g.SaveGame()
+	}
+}
+
+// applySongDoors re-opens the song doors already opened on the current screen.
+func (g *Game) applySongDoors(screen *world.Screen) {
+	prefix := g.screenKey() + "_"
+	for key := range g.SongDoors {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		var tx, ty int
+		if _, err := fmt.Sscanf(key[len(prefix):], "%d,%d", &tx, &ty); err != nil {
+			continue
+		}
+		if screen.TileAt(tx, ty) == world.TileSongDoor {
+			screen.Tiles[ty][tx] = world.TileDoorOpen
+		}
+	}
+}
+
+// checkWarpPoint activates the overworld warp point the player stands on.
+// Keys are "sx,sy_tx,ty".
+func (g *Game) checkWarpPoint() {
+	if g.Location != LocationOverworld {
+		return
+	}
+	tx := int(g.Player.CenterX()) / config.TileSize
+	ty := int(g.Player.CenterY()) / config.TileSize
+	if g.Overworld.CurrentScreen().TileAt(tx, ty) != world.TileWarpTile {
+		return
+	}
+	key := fmt.Sprintf("%d,%d_%d,%d", g.Overworld.CurrentX, g.Overworld.CurrentY, tx, ty)
+	if g.Quest.WarpPoints[key] {
+		return
+	}
+	g.Quest.WarpPoints[key] = true
+	g.Dialogue.StartMessage("", []string{"You found a warp", "point! Manbo's Mambo", "will bring you back."})
+	g.State = StateDialogue
+	g.Audio.PlayItemGet()
+	g.SaveGame()
+}
+
+// warpWithMambo fades out to the next activated warp point after the one
+// on this screen. Dungeons block the warp.
+func (g *Game) warpWithMambo() {
+	if g.Location == LocationDungeon || len(g.Quest.WarpPoints) == 0 {
+		g.Dialogue.StartMessage("", []string{"The melody echoes,", "but nothing happens."})
+		g.State = StateDialogue
+		return
+	}
+
+	keys := make([]string, 0, len(g.Quest.WarpPoints))
+	for key := range g.Quest.WarpPoints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	here := fmt.Sprintf("%d,%d_", g.Overworld.CurrentX, g.Overworld.CurrentY)
+	next := 0
+	for i, key := range keys {
+		if !g.InInterior && strings.HasPrefix(key, here) {
+			next = (i + 1) % len(keys)
+		}
+	}
+
+	var sx, sy, tx, ty int
+	if _, err := fmt.Sscanf(keys[next], "%d,%d_%d,%d", &sx, &sy, &tx, &ty); err != nil {
+		return
+	}
+	g.PendingExitLink = &world.DoorLink{
+		ScreenX: sx,
+		ScreenY: sy,
+		ExitX:   float64(tx*config.TileSize) + 1,
+		ExitY:   float64(ty*config.TileSize) + 1,
+	}
+	g.Transition.StartFade()
+}
+
+// playSongOfSoul wakes the sleepers on screen and calms its enemies.
+func (g *Game) playSongOfSoul() {
+	for _, npc := range g.NPCs {
+		if npc.Asleep {
+			npc.Asleep = false
+			g.Quest.SetFlag("awake_" + npc.ID)
+		}
+	}
+	for _, e := range g.Enemies {
+		if !e.Dead && e.Type != entity.EnemyBoss {
+			e.StunTimer = config.SongCalmTime
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package game
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// sprinklePowder scatters a pinch of Magic Powder over the tile in front of
+// the player. It lights torches, transforms enemies that react to it and
+// sets the quest flag of any powder-reactive NPC it lands on.
+func (g *Game) sprinklePowder() {
+	p := g.Player
+	p.Inventory.Powder--
+	g.Audio.PlayPowder()
+
+	ts := float64(config.TileSize)
+	cx := p.CenterX() + p.Dir.DX()*ts
+	cy := p.CenterY() + p.Dir.DY()*ts
+	ax, ay := cx-ts/2, cy-ts/2
+
+	tx, ty := int(cx)/config.TileSize, int(cy)/config.TileSize
+	g.spawnTileParticles(tx, ty, 230, 120, 230)
+
+	screen := g.currentScreen()
+	if screen.TileAt(tx, ty) == world.TileTorch {
+		screen.Tiles[ty][tx] = world.TileTorchLit
+	}
+
+	for i, e := range g.Enemies {
+		if e.Dead || !system.AABBOverlap(ax, ay, ts, ts, e.X, e.Y, float64(e.Width), float64(e.Height)) {
+			continue
+		}
+		switch effect, into := system.EnemyPowder(e.Type); effect {
+		case system.PowderFairy:
+			e.Dead = true
+			g.Items = append(g.Items, entity.NewItem(entity.ItemFairy, e.X, e.Y))
+		case system.PowderTransform:
+			g.Enemies[i] = newEnemy(into, e.X, e.Y)
+		}
+	}
+
+	for _, npc := range g.NPCs {
+		if npc.PowderFlag == "" || g.Quest.HasFlag(npc.PowderFlag) {
+			continue
+		}
+		if system.AABBOverlap(ax, ay, ts, ts, npc.X, npc.Y, float64(npc.Width), float64(npc.Height)) {
+			g.Quest.SetFlag(npc.PowderFlag)
+			lines, _ := g.getActiveDialogue(npc)
+			g.Dialogue.StartWithLines(npc, lines)
+			g.State = StateDialogue
+			g.SaveGame()
+			return
+		}
+	}
+}
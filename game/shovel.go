@@ -0,0 +1,86 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// digHole is a hole dug on the current screen, waiting to fill back in.
+type digHole struct {
+	TileX, TileY int
+	Timer        float64
+}
+
+// dig turns over the ground the player is facing. Diggable ground leaves a
+// hole and may turn up buried treasure; anything else just clinks.
+func (g *Game) dig() {
+	screen := g.currentScreen()
+	tx, ty := g.facingTile()
+	if !world.TileProps[screen.TileAt(tx, ty)].Diggable {
+		g.Audio.PlayShieldBlock()
+		return
+	}
+
+	screen.DigTile(tx, ty)
+	g.Holes = append(g.Holes, digHole{TileX: tx, TileY: ty, Timer: config.HoleTime})
+	g.spawnTileParticles(tx, ty, 130, 90, 50)
+	g.Audio.PlayDig()
+
+	x := float64(tx*config.TileSize) + 2
+	y := float64(ty*config.TileSize) + 2
+	for _, b := range screen.Buried {
+		if b.TileX != tx || b.TileY != ty {
+			continue
+		}
+		key := fmt.Sprintf("buried_%s_%d,%d", g.screenKey(), tx, ty)
+		if g.CollectedItems[key] {
+			break
+		}
+		item := entity.NewItem(entity.ItemType(b.Type), x, y)
+		item.SaveKey = key
+		g.Items = append(g.Items, item)
+		g.Audio.PlayItemGet()
+		return
+	}
+
+	if typ, ok := g.rollDigFind(); ok {
+		g.Items = append(g.Items, entity.NewItem(typ, x, y))
+	}
+}
+
+// rollDigFind picks what a random dig turns up: mostly rupees, sometimes a
+// heart.
+func (g *Game) rollDigFind() (entity.ItemType, bool) {
+	roll := g.RNG.Next() % 100
+	if roll >= config.DigFindChance {
+		return 0, false
+	}
+	if roll < 8 {
+		return entity.ItemHeart, true
+	}
+	return entity.ItemRupee, true
+}
+
+// updateHoles fills dug holes back in once their time is up, but never
+// under the player's feet.
+func (g *Game) updateHoles(dt float64) {
+	screen := g.currentScreen()
+	px, py, pw, ph := g.Player.BBox()
+	open := g.Holes[:0]
+	for _, h := range g.Holes {
+		h.Timer -= dt
+		if h.Timer <= 0 {
+			ts := float64(config.TileSize)
+			if !system.AABBOverlap(px, py, pw, ph, float64(h.TileX)*ts, float64(h.TileY)*ts, ts, ts) {
+				screen.FillTile(h.TileX, h.TileY)
+				continue
+			}
+		}
+		open = append(open, h)
+	}
+	g.Holes = open
+}
@@ -0,0 +1,36 @@
+package game
+
+import "github.com/AchrafSoltani/GlowQuest/world"
+
+// tryReadSign reads out the signpost or owl statue the player faces. Owl
+// statues in a dungeon stay silent until its Stone Beak has been found.
+func (g *Game) tryReadSign() bool {
+	screen := g.currentScreen()
+	tx, ty := g.facingTile()
+	tile := screen.TileAt(tx, ty)
+	if tile != world.TileSignpost && tile != world.TileOwlStatue {
+		return false
+	}
+	sign := screen.SignAt(tx, ty)
+	if sign == nil {
+		return false
+	}
+	if tile == world.TileOwlStatue && g.Location == LocationDungeon &&
+		g.CurrentDungeon != nil && !g.CurrentDungeon.HasStoneBeak {
+		return false
+	}
+
+	lines := sign.Text
+	for _, opt := range sign.Options {
+		if CheckCondition(opt.Condition, g.Quest, &g.Player.Inventory) {
+			lines = opt.Lines
+			if opt.SetFlag != "" {
+				g.Quest.SetFlag(opt.SetFlag)
+			}
+			break
+		}
+	}
+	g.Dialogue.StartMessage("", lines)
+	g.State = StateDialogue
+	return true
+}
@@ -10,4 +10,7 @@ const (
 	StateDialogue
 	StateVictory
 	StateInventory
+	StateDungeonMap
+	StateSongSelect
+	StateSongPlaying
 )
@@ -0,0 +1,69 @@
+package game
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// updateSwimming sets whether the player is swimming from the tile under
+// their centre. Wading into deep water without Flippers drowns them back
+// to the last dry spot for a heart of damage. Returns true if drowning
+// ended the game.
+func (g *Game) updateSwimming(dt float64) bool {
+	p := g.Player
+	if p.Jumping || (g.Hookshot != nil && g.Hookshot.State == entity.HookPulling) {
+		return false
+	}
+
+	tx := int(p.CenterX()) / config.TileSize
+	ty := int(p.CenterY()) / config.TileSize
+	tile := g.currentScreen().TileAt(tx, ty)
+	props := world.TileProps[tile]
+
+	if !props.Swimmable {
+		if p.Swimming {
+			g.spawnSplash()
+		}
+		p.Swimming = false
+		p.Diving = false
+		if props.Passable {
+			p.SafeX = p.X
+			p.SafeY = p.Y
+		}
+		return false
+	}
+
+	if p.Inventory.OwnedItems[entity.EquipFlippers] {
+		if !p.Swimming {
+			p.Swimming = true
+			p.StopDash()
+			g.spawnSplash()
+		}
+		p.UpdateDive(dt)
+		return false
+	}
+
+	if tile != world.TileWater {
+		return false
+	}
+
+	// No Flippers: sink and come back out on dry ground
+	g.spawnSplash()
+	p.StopDash()
+	p.X = p.SafeX
+	p.Y = p.SafeY
+	g.damagePlayer(config.DrownDamage)
+	if g.State == StateGameOver {
+		return true
+	}
+	p.InvTimer = config.PlayerInvTime
+	return false
+}
+
+// spawnSplash throws up water droplets around the player.
+func (g *Game) spawnSplash() {
+	tx := int(g.Player.CenterX()) / config.TileSize
+	ty := int(g.Player.CenterY()) / config.TileSize
+	g.spawnTileParticles(tx, ty, 120, 180, 230)
+}
@@ -0,0 +1,175 @@
+package game
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/system"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
+
+// slidingBlock is a pushed block on its way to the next tile of a room.
+type slidingBlock struct {
+	*entity.PushBlock
+	room *world.DungeonRoom
+}
+
+// switchRoom returns the dungeon room whose blocks and switches are live,
+// or nil outside dungeons.
+func (g *Game) switchRoom() *world.DungeonRoom {
+	if g.Location != LocationDungeon || g.CurrentDungeon == nil {
+		return nil
+	}
+	return g.CurrentDungeon.CurrentDungeonRoom()
+}
+
+// updatePush leans on a block the player walks into and slides it one tile
+// once they have pushed for long enough.
+func (g *Game) updatePush(dt float64) {
+	p := g.Player
+	room := g.switchRoom()
+	tx, ty := g.facingTile()
+	if room == nil || !p.Moving || p.Jumping || p.Lifting || p.Swimming ||
+		room.Screen.TileAt(tx, ty) != world.TileBlock ||
+		!system.TileCollision(room.Screen, p.X+p.Dir.DX(), p.Y+p.Dir.DY(), p.Width, p.Height) {
+		p.Pushing = false
+		p.PushTimer = 0
+		return
+	}
+
+	p.Pushing = true
+	p.PushTimer += dt
+	if p.PushTimer < config.PushTime {
+		return
+	}
+	p.PushTimer = 0
+
+	nx, ny := tx+int(p.Dir.DX()), ty+int(p.Dir.DY())
+	if !g.blockCanEnter(room, nx, ny) {
+		return
+	}
+	room.LiftBlock(tx, ty)
+	room.ApplySwitches()
+	g.Blocks = append(g.Blocks, slidingBlock{entity.NewPushBlock(tx, ty, p.Dir), room})
+	g.Audio.PlayPush()
+}
+
+// blockCanEnter returns true if a pushed block may slide onto (gx, gy):
+// open floor away from the doorways that no other block is heading for.
+func (g *Game) blockCanEnter(room *world.DungeonRoom, gx, gy int) bool {
+	if gx <= 0 || gy <= 0 || gx >= config.ScreenGridW-1 || gy >= config.ScreenGridH-1 {
+		return false
+	}
+	props := world.TileProps[room.Screen.TileAt(gx, gy)]
+	if !props.Passable || props.Fall {
+		return false
+	}
+	for _, b := range g.Blocks {
+		if b.ToX == gx && b.ToY == gy {
+			return false
+		}
+	}
+	return true
+}
+
+// updateBlocks slides pushed blocks and sets them down on arrival.
+func (g *Game) updateBlocks(dt float64) {
+	alive := g.Blocks[:0]
+	for _, b := range g.Blocks {
+		if b.Update(dt) {
+			b.room.PlaceBlock(b.ToX, b.ToY)
+			g.CurrentDungeon.RecordPuzzle(b.room)
+			continue
+		}
+		alive = append(alive, b)
+	}
+	g.Blocks = alive
+}
+
+// settleBlocks drops any block still sliding straight onto its destination,
+// so leaving a room mid-push doesn't lose it.
+func (g *Game) settleBlocks() {
+	for _, b := range g.Blocks {
+		b.room.PlaceBlock(b.ToX, b.ToY)
+		if g.CurrentDungeon != nil {
+			g.CurrentDungeon.RecordPuzzle(b.room)
+		}
+	}
+	g.Blocks = nil
+}
+
+// switchWeighed returns true if a block rests on a switch or the player
+// stands on it.
+func (g *Game) switchWeighed(room *world.DungeonRoom, sw *world.RoomSwitch) bool {
+	if room.Screen.Tiles[sw.Y][sw.X] == world.TileBlock {
+		return true
+	}
+	p := g.Player
+	if p.Jumping || p.Falling {
+		return false
+	}
+	ts := config.TileSize
+	return int(p.CenterX())/ts == sw.X && int(p.CenterY())/ts == sw.Y
+}
+
+// weighSwitches records which switches are already weighed down, so that
+// entering a room doesn't count as stepping onto them.
+func (g *Game) weighSwitches(room *world.DungeonRoom) {
+	g.SwitchWeight = make(map[[2]int]bool)
+	for i := range room.Switches {
+		sw := &room.Switches[i]
+		g.SwitchWeight[[2]int{sw.X, sw.Y}] = g.switchWeighed(room, sw)
+	}
+}
+
+// updateSwitches presses the floor switches the player or a block weighs
+// down and applies their targets.
+func (g *Game) updateSwitches() {
+	room := g.switchRoom()
+	if room == nil || len(room.Switches) == 0 {
+		return
+	}
+	if g.SwitchWeight == nil {
+		g.weighSwitches(room)
+	}
+
+	changed := false
+	for i := range room.Switches {
+		sw := &room.Switches[i]
+		pos := [2]int{sw.X, sw.Y}
+		weighed := g.switchWeighed(room, sw)
+		stepped := weighed && !g.SwitchWeight[pos]
+		g.SwitchWeight[pos] = weighed
+
+		switch sw.Mode {
+		case world.SwitchHold:
+			if sw.Pressed != weighed {
+				sw.Pressed = weighed
+				changed = true
+			}
+		case world.SwitchToggle:
+			if stepped {
+				sw.Pressed = !sw.Pressed
+				changed = true
+			}
+		default:
+			if stepped && !sw.Pressed {
+				sw.Pressed = true
+				changed = true
+			}
+		}
+	}
+	if changed {
+		g.applySwitches(room)
+	}
+}
+
+// applySwitches updates a room's switch targets, with a rumble if any of
+// them moved, and records the switches for the save.
+func (g *Game) applySwitches(room *world.DungeonRoom) {
+	g.CurrentDungeon.RecordPuzzle(room)
+	if room.ApplySwitches() {
+		g.Audio.PlayDoorOpen()
+	} else {
+		g.Audio.PlayMenuSelect()
+	}
+}
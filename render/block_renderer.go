@@ -0,0 +1,21 @@
+package render
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+)
+
+// DrawPushBlockAt renders a block sliding after a push.
+func DrawPushBlockAt(sc *ScaledCanvas, b *entity.PushBlock, offsetX, offsetY int) {
+	drawBlock(sc, int(b.X)+offsetX, int(b.Y)+config.HUDHeight+offsetY)
+}
+
+// drawBlock draws a stone push block filling the tile at (px, py).
+func drawBlock(sc *ScaledCanvas, px, py int) {
+	ts := config.TileSize
+	sc.DrawRect(px, py, ts, ts, ColorBlockDark)
+	sc.DrawRect(px+1, py+1, ts-2, ts-3, ColorBlock)
+	sc.DrawLine(px+1, py+1, px+ts-2, py+1, ColorBlockLight)
+	sc.DrawLine(px+1, py+1, px+1, py+ts-3, ColorBlockLight)
+	sc.DrawRectOutline(px+4, py+4, ts-8, ts-9, ColorBlockDark)
+}
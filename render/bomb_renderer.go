@@ -0,0 +1,45 @@
+package render
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/glow"
+)
+
+var (
+	ColorBomb          = glow.RGB(40, 40, 60)
+	ColorBombFlash     = glow.RGB(200, 60, 60)
+	ColorBombFuse      = glow.RGB(200, 100, 30)
+	ColorBombSpark     = glow.RGB(255, 230, 80)
+	ColorExplosion     = glow.RGB(255, 160, 40)
+	ColorExplosionCore = glow.RGB(255, 240, 180)
+)
+
+// DrawBombAt renders a bomb with its fuse spark, raised by its lift.
+func DrawBombAt(sc *ScaledCanvas, b *entity.Bomb, offsetX, offsetY int) {
+	px := int(b.X) + offsetX
+	py := int(b.Y) + config.HUDHeight + offsetY
+
+	// Shadow stays on the ground while airborne
+	if b.Lift > 0 && !b.Carried {
+		sc.DrawRect(px+2, py+10, 8, 2, ColorShadow)
+	}
+	py -= int(b.Lift)
+
+	body := ColorBomb
+	if b.FuseFlash() {
+		body = ColorBombFlash
+	}
+	sc.FillCircle(px+6, py+7, 5, body)
+	sc.DrawRect(px+5, py, 2, 3, ColorBombFuse)
+	sc.SetPixel(px+6, py-1, ColorBombSpark)
+}
+
+// DrawExplosionAt renders an expanding blast ring.
+func DrawExplosionAt(sc *ScaledCanvas, e *entity.Explosion, offsetX, offsetY int) {
+	cx := int(e.X) + offsetX
+	cy := int(e.Y) + config.HUDHeight + offsetY
+	r := int(config.BombRadius * (0.4 + 0.6*e.Progress()))
+	sc.FillCircle(cx, cy, r, ColorExplosion)
+	sc.FillCircle(cx, cy, r/2, ColorExplosionCore)
+}
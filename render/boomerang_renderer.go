@@ -0,0 +1,34 @@
+package render
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/glow"
+)
+
+var (
+	ColorBoomerang     = glow.RGB(80, 130, 220)
+	ColorBoomerangDark = glow.RGB(40, 80, 160)
+)
+
+// DrawBoomerangAt renders the spinning boomerang as an L shape turned a
+// quarter per frame.
+func DrawBoomerangAt(sc *ScaledCanvas, b *entity.Boomerang, offsetX, offsetY int) {
+	px := int(b.X) + offsetX
+	py := int(b.Y) + config.HUDHeight + offsetY
+
+	switch b.Frame() {
+	case 0:
+		sc.DrawRect(px, py, 8, 2, ColorBoomerang)
+		sc.DrawRect(px, py, 2, 8, ColorBoomerangDark)
+	case 1:
+		sc.DrawRect(px, py, 8, 2, ColorBoomerang)
+		sc.DrawRect(px+6, py, 2, 8, ColorBoomerangDark)
+	case 2:
+		sc.DrawRect(px, py+6, 8, 2, ColorBoomerang)
+		sc.DrawRect(px+6, py, 2, 8, ColorBoomerangDark)
+	default:
+		sc.DrawRect(px, py+6, 8, 2, ColorBoomerang)
+		sc.DrawRect(px, py, 2, 8, ColorBoomerangDark)
+	}
+}
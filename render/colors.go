@@ -76,4 +76,14 @@ var (
 	ColorRoof         = glow.RGB(160, 60, 40)
 	ColorWindow       = glow.RGB(100, 150, 200)
 	ColorFence        = glow.RGB(140, 110, 60)
+	ColorShutter      = glow.RGB(110, 110, 120)
+	ColorSongDoor     = glow.RGB(90, 110, 170)
+	ColorSongNote     = glow.RGB(240, 230, 140)
+	ColorHole         = glow.RGB(90, 60, 30)
+	ColorHoleDark     = glow.RGB(50, 30, 15)
+	ColorBlock        = glow.RGB(150, 140, 150)
+	ColorBlockDark    = glow.RGB(90, 85, 100)
+	ColorBlockLight   = glow.RGB(200, 195, 205)
+	ColorBarrier      = glow.RGB(70, 110, 170)
+	ColorBarrierDark  = glow.RGB(40, 70, 120)
 )
@@ -0,0 +1,116 @@
+package render
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/world"
+	"github.com/AchrafSoltani/glow"
+)
+
+var (
+	ColorMapVisited   = glow.RGB(90, 140, 200)
+	ColorMapUnvisited = glow.RGB(50, 60, 90)
+	ColorMapDoor      = glow.RGB(200, 200, 220)
+	ColorMapCurrent   = glow.RGB(255, 255, 100)
+	ColorMapChest     = glow.RGB(230, 170, 40)
+	ColorMapBoss      = glow.RGB(220, 40, 40)
+)
+
+const (
+	mapCellW = 24
+	mapCellH = 18
+	mapGap   = 4
+)
+
+// DrawDungeonMapScreen draws the dungeon map overlay. Visited rooms are
+// always shown; the map reveals the whole layout and the compass marks
+// unopened chests and the boss room.
+func DrawDungeonMapScreen(sc *ScaledCanvas, d *world.Dungeon) {
+	// Darken background
+	for y := 0; y < config.WindowHeight; y++ {
+		for x := 0; x < config.WindowWidth; x++ {
+			if (x+y)%3 != 0 {
+				sc.SetPixel(x, y, ColorInvBG)
+			}
+		}
+	}
+
+	// Title
+	tw := TextWidth(d.Name)
+	DrawText(sc, d.Name, (config.WindowWidth-tw)/2, 4, ColorHUDText)
+
+	// Centre the room grid in the window
+	gridW := d.GridW*(mapCellW+mapGap) - mapGap
+	gridH := d.GridH*(mapCellH+mapGap) - mapGap
+	originX := (config.WindowWidth - gridW) / 2
+	originY := 18 + (config.WindowHeight-36-gridH)/2
+
+	for pos, room := range d.Rooms {
+		visible := d.HasMap || d.VisitedRooms[pos]
+		cx := originX + pos[0]*(mapCellW+mapGap)
+		cy := originY + pos[1]*(mapCellH+mapGap)
+
+		if visible {
+			color := ColorMapUnvisited
+			if d.VisitedRooms[pos] {
+				color = ColorMapVisited
+			}
+			sc.DrawRect(cx, cy, mapCellW, mapCellH, color)
+			drawMapDoors(sc, room, cx, cy)
+		}
+
+		if d.HasCompass {
+			for _, c := range room.Chests {
+				if !c.Opened {
+					sc.DrawRect(cx+3, cy+3, 4, 3, ColorMapChest)
+					break
+				}
+			}
+			if pos == d.BossRoomPos {
+				sc.FillCircle(cx+mapCellW/2, cy+mapCellH/2, 3, ColorMapBoss)
+			}
+		}
+
+		if pos == d.CurrentRoom {
+			sc.DrawRectOutline(cx-1, cy-1, mapCellW+2, mapCellH+2, ColorMapCurrent)
+			sc.DrawRect(cx+mapCellW/2-1, cy+mapCellH/2-1, 3, 3, ColorMapCurrent)
+		}
+	}
+
+	// Legend
+	status := "MAP:"
+	if d.HasMap {
+		status += "YES"
+	} else {
+		status += "NO"
+	}
+	status += "  COMPASS:"
+	if d.HasCompass {
+		status += "YES"
+	} else {
+		status += "NO"
+	}
+	DrawText(sc, status, 10, config.WindowHeight-24, ColorHUDText)
+
+	inst := "SPACE:BACK  TAB:CLOSE"
+	iw := TextWidth(inst)
+	DrawText(sc, inst, (config.WindowWidth-iw)/2, config.WindowHeight-12, ColorMenuDisabled)
+}
+
+// drawMapDoors draws a short connector in the gap beside each door of a room.
+func drawMapDoors(sc *ScaledCanvas, room *world.DungeonRoom, cx, cy int) {
+	for side, door := range room.Doors {
+		if door == nil {
+			continue
+		}
+		switch side {
+		case world.SideNorth:
+			sc.DrawRect(cx+mapCellW/2-2, cy-mapGap/2, 4, mapGap/2, ColorMapDoor)
+		case world.SideSouth:
+			sc.DrawRect(cx+mapCellW/2-2, cy+mapCellH, 4, mapGap/2, ColorMapDoor)
+		case world.SideEast:
+			sc.DrawRect(cx+mapCellW, cy+mapCellH/2-2, mapGap/2, 4, ColorMapDoor)
+		case world.SideWest:
+			sc.DrawRect(cx-mapGap/2, cy+mapCellH/2-2, mapGap/2, 4, ColorMapDoor)
+		}
+	}
+}
@@ -9,10 +9,13 @@ import (
 var (
 	ColorOctorok     = glow.RGB(200, 50, 50)
 	ColorOctorokDark = glow.RGB(140, 30, 30)
+	ColorIceOctorok  = glow.RGB(150, 200, 240)
+	ColorIceDarkBody = glow.RGB(80, 130, 190)
 	ColorMoblin      = glow.RGB(160, 100, 50)
 	ColorMoblinDark  = glow.RGB(110, 70, 30)
 	ColorStalfos     = glow.RGB(180, 180, 180)
 	ColorStalfosDark = glow.RGB(120, 120, 120)
+	ColorStun        = glow.RGB(255, 230, 80)
 )
 
 // DrawEnemy renders an enemy sprite at its position.
@@ -38,7 +41,7 @@ func DrawEnemyAt(sc *ScaledCanvas, e *entity.Enemy, offsetX, offsetY int) {
 	py := int(e.Y) + config.HUDHeight + offsetY
 
 	switch e.Type {
-	case entity.EnemyOctorok:
+	case entity.EnemyOctorok, entity.EnemyIceOctorok:
 		drawOctorok(sc, px, py, e)
 	case entity.EnemyMoblin:
 		drawMoblin(sc, px, py, e)
@@ -47,12 +50,23 @@ func DrawEnemyAt(sc *ScaledCanvas, e *entity.Enemy, offsetX, offsetY int) {
 	case entity.EnemyBoss:
 		drawBoss(sc, px, py, e)
 	}
+
+	// Stars circling a stunned enemy's head
+	if e.StunTimer > 0 {
+		spin := int(e.StunTimer*8) % 2
+		sc.SetPixel(px+2+spin*2, py-2, ColorStun)
+		sc.SetPixel(px+e.Width-3-spin*2, py-3, ColorStun)
+	}
 }
 
 func drawOctorok(sc *ScaledCanvas, px, py int, e *entity.Enemy) {
-	// Round red body
-	sc.FillCircle(px+7, py+7, 6, ColorOctorok)
-	sc.FillCircle(px+7, py+7, 4, ColorOctorokDark)
+	// Round body: red, or pale blue for the ice kind
+	body, dark := ColorOctorok, ColorOctorokDark
+	if e.Type == entity.EnemyIceOctorok {
+		body, dark = ColorIceOctorok, ColorIceDarkBody
+	}
+	sc.FillCircle(px+7, py+7, 6, body)
+	sc.FillCircle(px+7, py+7, 4, dark)
 
 	// Eyes based on direction
 	switch e.Dir {
@@ -75,8 +89,8 @@ func drawOctorok(sc *ScaledCanvas, px, py int, e *entity.Enemy) {
 	if e.WalkFrame%2 == 1 {
 		legOff = 1
 	}
-	sc.DrawRect(px+3+legOff, py+12, 2, 2, ColorOctorokDark)
-	sc.DrawRect(px+9-legOff, py+12, 2, 2, ColorOctorokDark)
+	sc.DrawRect(px+3+legOff, py+12, 2, 2, dark)
+	sc.DrawRect(px+9-legOff, py+12, 2, 2, dark)
 }
 
 func drawMoblin(sc *ScaledCanvas, px, py int, e *entity.Enemy) {
@@ -0,0 +1,39 @@
+package render
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/glow"
+)
+
+var (
+	ColorChain   = glow.RGB(150, 150, 170)
+	ColorHookTip = glow.RGB(220, 220, 240)
+)
+
+// DrawHookshotAt renders the chain as links from the player out to the hook.
+func DrawHookshotAt(sc *ScaledCanvas, h *entity.Hookshot, offsetX, offsetY int) {
+	dx, dy := h.Dir.DX(), h.Dir.DY()
+	length := h.Length()
+	oy := float64(config.HUDHeight + offsetY)
+	ox := float64(offsetX)
+
+	// One link every 4 pixels, starting clear of the player's hand
+	for d := 6.0; d < length-2; d += 4 {
+		x := int(h.OriginX + dx*d + ox)
+		y := int(h.OriginY + dy*d + oy)
+		sc.DrawRect(x-1, y-1, 2, 2, ColorChain)
+	}
+
+	tx := int(h.TipX + ox)
+	ty := int(h.TipY + oy)
+	sc.DrawRect(tx-2, ty-2, 4, 4, ColorHookTip)
+	// Barbs either side of the tip
+	if dx != 0 {
+		sc.SetPixel(tx-int(dx)*3, ty-3, ColorHookTip)
+		sc.SetPixel(tx-int(dx)*3, ty+2, ColorHookTip)
+	} else {
+		sc.SetPixel(tx-3, ty-int(dy)*3, ColorHookTip)
+		sc.SetPixel(tx+2, ty-int(dy)*3, ColorHookTip)
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/AchrafSoltani/glow"
 )
 
-func DrawHUD(sc *ScaledCanvas, p *entity.Player) {
+// DrawHUD draws the status bar. keys is the key count to show, which is the
+// dungeon's small keys while inside one.
+func DrawHUD(sc *ScaledCanvas, p *entity.Player, keys int) {
 	// HUD background (32px tall)
 	sc.DrawRect(0, 0, config.WindowWidth, config.HUDHeight, ColorHUD)
 
@@ -70,7 +72,7 @@ func DrawHUD(sc *ScaledCanvas, p *entity.Player) {
 	sc.FillCircle(keyX+2, infoY+2, 2, ColorKey)
 	sc.DrawRect(keyX+1, infoY+3, 2, 3, ColorKey)
 	sc.SetPixel(keyX+3, infoY+4, ColorKey)
-	DrawText(sc, fmt.Sprintf("%d", p.Inventory.Keys), keyX+6, infoY+1, ColorHUDText)
+	DrawText(sc, fmt.Sprintf("%d", keys), keyX+6, infoY+1, ColorHUDText)
 
 	// Bombs count (if player has bombs)
 	if p.Inventory.OwnedItems[entity.EquipBomb] {
@@ -80,6 +82,22 @@ func DrawHUD(sc *ScaledCanvas, p *entity.Player) {
 		DrawText(sc, fmt.Sprintf("%02d", p.Inventory.Bombs), bombX+6, infoY+1, ColorHUDText)
 	}
 
+	// Arrows count (if player has the bow)
+	if p.Inventory.OwnedItems[entity.EquipBow] {
+		arrowX := keyX + 50
+		sc.DrawRect(arrowX, infoY+3, 5, 1, ColorArrowShaft)
+		sc.DrawRect(arrowX+5, infoY+2, 1, 3, ColorArrowHead)
+		DrawText(sc, fmt.Sprintf("%02d", p.Inventory.Arrows), arrowX+8, infoY+1, ColorHUDText)
+	}
+
+	// Powder count (if player has magic powder)
+	if p.Inventory.OwnedItems[entity.EquipMagicPowder] {
+		powderX := keyX + 75
+		sc.FillCircle(powderX+2, infoY+3, 2, ColorPowderBag)
+		sc.SetPixel(powderX+4, infoY, ColorPowder)
+		DrawText(sc, fmt.Sprintf("%02d", p.Inventory.Powder), powderX+6, infoY+1, ColorHUDText)
+	}
+
 	// Separator line
 	sc.DrawLine(0, config.HUDHeight-1, config.WindowWidth-1, config.HUDHeight-1, glow.RGB(60, 60, 60))
 }
@@ -103,6 +121,11 @@ func drawHUDItemIcon(sc *ScaledCanvas, id entity.EquipItemID, x, y int) {
 	case entity.EquipRocsFeather:
 		sc.DrawRect(x+3, y+2, 2, 8, glow.RGB(200, 200, 200))
 		sc.DrawRect(x+5, y+1, 4, 5, glow.RGB(230, 230, 240))
+	case entity.EquipMagicPowder:
+		sc.FillCircle(x+6, y+7, 4, ColorPowderBag)
+		sc.DrawRect(x+5, y+1, 3, 2, ColorPowderBag)
+		sc.SetPixel(x+2, y+1, ColorPowder)
+		sc.SetPixel(x+10, y+3, ColorPowder)
 	case entity.EquipNone:
 		// empty slot
 	default:
@@ -1,6 +1,8 @@
 package render
 
 import (
+	"strings"
+
 	"github.com/AchrafSoltani/GlowQuest/config"
 	"github.com/AchrafSoltani/GlowQuest/entity"
 	"github.com/AchrafSoltani/glow"
@@ -15,6 +17,16 @@ var (
 	ColorSwordDark      = glow.RGB(140, 140, 160)
 	ColorHeartContainer = glow.RGB(255, 50, 50)
 	ColorHeartContGold  = glow.RGB(230, 190, 50)
+	ColorMapPaper       = glow.RGB(220, 200, 150)
+	ColorCompass        = glow.RGB(200, 60, 60)
+	ColorStoneBeak      = glow.RGB(150, 150, 160)
+	ColorNightmareKey   = glow.RGB(200, 60, 200)
+	ColorSeashell       = glow.RGB(240, 220, 200)
+	ColorSeashellDark   = glow.RGB(200, 150, 130)
+	ColorPowderBag      = glow.RGB(160, 110, 60)
+	ColorPowder         = glow.RGB(230, 120, 230)
+	ColorFairy          = glow.RGB(255, 160, 200)
+	ColorFairyWing      = glow.RGB(200, 230, 255)
 )
 
 // DrawItem renders an item sprite at its position with bobbing animation.
@@ -24,7 +36,7 @@ func DrawItem(sc *ScaledCanvas, item *entity.Item) {
 
 // DrawItemAt renders an item with a pixel offset.
 func DrawItemAt(sc *ScaledCanvas, item *entity.Item, offsetX, offsetY int) {
-	if item.Collected {
+	if item.Collected || item.Hidden {
 		return
 	}
 
@@ -43,6 +55,16 @@ func DrawItemAt(sc *ScaledCanvas, item *entity.Item, offsetX, offsetY int) {
 		drawItemSword(sc, px, py)
 	case entity.ItemHeartContainer:
 		drawItemHeartContainer(sc, px, py)
+	case entity.ItemArrows:
+		drawItemArrows(sc, px, py)
+	case entity.ItemBombs:
+		drawItemBombs(sc, px, py)
+	case entity.ItemSeashell:
+		drawItemSeashell(sc, px, py)
+	case entity.ItemPowder:
+		drawItemPowder(sc, px, py)
+	case entity.ItemFairy:
+		drawItemFairy(sc, px, py)
 	}
 }
 
@@ -89,6 +111,51 @@ func drawItemSword(sc *ScaledCanvas, px, py int) {
 	sc.DrawRect(px+5, py+8, 2, 3, ColorKeyDark)
 }
 
+func drawItemArrows(sc *ScaledCanvas, px, py int) {
+	// Bundle of three arrows
+	for i := 0; i < 3; i++ {
+		x := px + 3 + i*3
+		sc.DrawRect(x, py+3, 1, 8, ColorArrowShaft)
+		sc.DrawRect(x-1, py+1, 3, 2, ColorArrowHead)
+	}
+}
+
+func drawItemBombs(sc *ScaledCanvas, px, py int) {
+	// Single bomb with fuse
+	sc.FillCircle(px+5, py+7, 4, ColorBomb)
+	sc.DrawRect(px+5, py+1, 1, 3, ColorBombFuse)
+	sc.SetPixel(px+5, py, ColorBombSpark)
+}
+
+func drawItemSeashell(sc *ScaledCanvas, px, py int) {
+	// Fan-shaped shell with ridges
+	sc.FillCircle(px+5, py+5, 4, ColorSeashell)
+	sc.DrawRect(px+1, py+5, 9, 4, ColorSeashell)
+	sc.DrawRect(px+4, py+9, 3, 2, ColorSeashellDark)
+	sc.DrawLine(px+5, py+2, px+5, py+8, ColorSeashellDark)
+	sc.DrawLine(px+3, py+3, px+3, py+8, ColorSeashellDark)
+	sc.DrawLine(px+7, py+3, px+7, py+8, ColorSeashellDark)
+}
+
+func drawItemPowder(sc *ScaledCanvas, px, py int) {
+	// Tied pouch with sparkles spilling out
+	sc.FillCircle(px+5, py+7, 4, ColorPowderBag)
+	sc.DrawRect(px+4, py+2, 3, 2, ColorPowderBag)
+	sc.SetPixel(px+2, py+1, ColorPowder)
+	sc.SetPixel(px+8, py+2, ColorPowder)
+	sc.SetPixel(px+9, py+5, ColorPowder)
+}
+
+func drawItemFairy(sc *ScaledCanvas, px, py int) {
+	// Little body between two pairs of wings
+	sc.DrawRect(px+1, py+2, 3, 3, ColorFairyWing)
+	sc.DrawRect(px+7, py+2, 3, 3, ColorFairyWing)
+	sc.DrawRect(px+2, py+6, 2, 2, ColorFairyWing)
+	sc.DrawRect(px+7, py+6, 2, 2, ColorFairyWing)
+	sc.FillCircle(px+5, py+3, 2, ColorFairy)
+	sc.DrawRect(px+4, py+5, 3, 4, ColorFairy)
+}
+
 func drawItemHeartContainer(sc *ScaledCanvas, px, py int) {
 	// Large heart with gold border
 	sc.FillCircle(px+3, py+3, 3, ColorHeartContGold)
@@ -101,3 +168,39 @@ func drawItemHeartContainer(sc *ScaledCanvas, px, py int) {
 	sc.DrawRect(px+2, py+4, 8, 3, ColorHeartContainer)
 	sc.DrawRect(px+3, py+7, 6, 2, ColorHeartContainer)
 }
+
+// DrawHeldItemAt draws chest contents held above the player's head during
+// the item-get pose.
+func DrawHeldItemAt(sc *ScaledCanvas, p *entity.Player, contents string, offsetX, offsetY int) {
+	px := int(p.X) + offsetX + 1
+	py := int(p.Y) + config.HUDHeight + offsetY - 12
+
+	key, _, _ := strings.Cut(contents, ":")
+	switch key {
+	case "rupees":
+		drawItemRupee(sc, px, py)
+	case "key":
+		drawItemKey(sc, px, py)
+	case "heart_piece":
+		drawItemHeartContainer(sc, px, py)
+	case "map":
+		sc.DrawRect(px+1, py+1, 10, 9, ColorMapPaper)
+		sc.DrawLine(px+3, py+4, px+8, py+4, ColorKeyDark)
+		sc.DrawLine(px+3, py+7, px+8, py+7, ColorKeyDark)
+	case "compass":
+		sc.FillCircle(px+6, py+5, 4, ColorKey)
+		sc.FillCircle(px+6, py+5, 3, ColorSword)
+		sc.DrawRect(px+6, py+2, 1, 3, ColorCompass)
+	case "stone_beak":
+		sc.DrawRect(px+3, py+2, 6, 4, ColorStoneBeak)
+		sc.DrawRect(px+5, py+6, 3, 3, ColorStoneBeak)
+	case "nightmare_key":
+		sc.FillCircle(px+6, py+3, 3, ColorNightmareKey)
+		sc.DrawRect(px+5, py+5, 2, 6, ColorNightmareKey)
+		sc.DrawRect(px+7, py+8, 3, 2, ColorNightmareKey)
+	default:
+		if id := entity.EquipItemByKey(key); id != entity.EquipNone {
+			drawInventoryItemIcon(sc, id, px, py)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package render
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+)
+
+// DrawLiftedAt renders a carried or thrown rock or pot, raised by its lift.
+func DrawLiftedAt(sc *ScaledCanvas, l *entity.Lifted, offsetX, offsetY int) {
+	if l.Dead {
+		return
+	}
+	px := int(l.X) + offsetX
+	py := int(l.Y) + config.HUDHeight + offsetY
+
+	// Shadow stays on the ground while airborne
+	if l.Lift > 0 && !l.Carried {
+		sc.DrawRect(px+2, py+12, 10, 2, ColorShadow)
+	}
+	py -= int(l.Lift)
+
+	switch l.Kind {
+	case entity.LiftedPot:
+		sc.DrawRect(px+2, py+4, 10, 10, ColorPot)
+		sc.DrawRect(px+3, py+2, 8, 3, ColorPotDark)
+		sc.DrawRect(px+4, py+1, 6, 2, ColorPot)
+	case entity.LiftedHeavyRock:
+		sc.DrawRect(px, py+2, 14, 11, ColorRockDark)
+		sc.DrawRect(px+1, py+3, 12, 9, ColorRock)
+		sc.SetPixel(px+3, py+5, ColorRockDark)
+		sc.SetPixel(px+9, py+7, ColorRockDark)
+	default:
+		sc.DrawRect(px+1, py+3, 12, 10, ColorRock)
+		sc.DrawRect(px+2, py+4, 10, 8, ColorRockDark)
+		sc.DrawLine(px+3, py+5, px+7, py+5, ColorRock)
+	}
+}
@@ -43,6 +43,11 @@ func DrawNPCAt(sc *ScaledCanvas, npc *entity.NPC, offsetX, offsetY int) {
 	px := int(npc.X) + offsetX
 	py := int(npc.Y) + config.HUDHeight + offsetY
 
+	// Sleepers snore until woken by the Song of Soul
+	if npc.Asleep {
+		DrawText(sc, "z", px+11, py-7, ColorSongNote)
+	}
+
 	hood, robe, robeDark := ColorNPCHood, ColorNPCRobe, ColorNPCRobeDark
 
 	switch npc.ID {
@@ -6,7 +6,13 @@ import (
 	"github.com/AchrafSoltani/glow"
 )
 
-var ColorSwordBlade = glow.RGB(200, 200, 220)
+var (
+	ColorSwordBlade  = glow.RGB(200, 200, 220)
+	ColorShield      = glow.RGB(60, 60, 200)
+	ColorShieldTrim  = glow.RGB(200, 50, 50)
+	ColorMirrorGlass = glow.RGB(170, 220, 240)
+	ColorShadow      = glow.RGB(30, 30, 30)
+)
 
 func DrawPlayer(sc *ScaledCanvas, p *entity.Player) {
 	DrawPlayerAt(sc, p, 0, 0)
@@ -37,6 +43,41 @@ func DrawPlayerAt(sc *ScaledCanvas, p *entity.Player, offsetX, offsetY int) {
 		}
 	}
 
+	// Airborne: shadow stays on the ground, sprite rises with the arc
+	if p.Jumping {
+		sc.DrawRect(px+3, py+12, 8, 2, ColorShadow)
+		sc.DrawRect(px+4, py+11, 6, 4, ColorShadow)
+		py -= int(p.JumpHeight)
+	}
+
+	// Falling: the player shrinks away into the pit
+	if p.Falling {
+		size := int(float64(p.Width) * (1 - p.FallTimer/config.FallTime))
+		if size > 0 {
+			off := (p.Width - size) / 2
+			sc.DrawRect(px+off, py+off, size, size, ColorTunic)
+			sc.DrawRect(px+off, py+off, size, size/3+1, ColorHat)
+		}
+		return
+	}
+
+	// Under the surface only ripples show; swimming hides the legs
+	if p.Diving {
+		drawWaterRipple(sc, px, py+6)
+		return
+	}
+	if p.Swimming {
+		py += 4
+	}
+
+	// Item-get pose: face the camera with both arms raised
+	if p.ItemGet {
+		drawPlayerDown(sc, px, py, 0)
+		sc.DrawRect(px+1, py-2, 2, 7, ColorSkin)
+		sc.DrawRect(px+11, py-2, 2, 7, ColorSkin)
+		return
+	}
+
 	switch p.Dir {
 	case entity.DirDown:
 		drawPlayerDown(sc, px, py, legOff)
@@ -48,9 +89,43 @@ func DrawPlayerAt(sc *ScaledCanvas, p *entity.Player, offsetX, offsetY int) {
 		drawPlayerRight(sc, px, py, legOff)
 	}
 
-	// Draw sword if swinging
+	if p.Swimming {
+		sc.DrawRect(px+1, py+9, 12, 5, ColorWater)
+		drawWaterRipple(sc, px, py+8)
+		return
+	}
+
+	// Draw sword if swinging, or held out in front during a dash
 	if p.Sword.Active {
 		drawSword(sc, px, py, p.Dir, p.Sword.Progress())
+	} else if p.Dashing && p.HasSword {
+		drawSword(sc, px, py, p.DashDir, 1)
+	}
+
+	// Raised shield in front of the player
+	if p.Shielding {
+		drawShield(sc, px, py, p.Dir, p.Inventory.ShieldLevel)
+	}
+}
+
+func drawShield(sc *ScaledCanvas, px, py int, dir entity.Direction, level int) {
+	face := ColorShieldTrim
+	if level >= 2 {
+		face = ColorMirrorGlass
+	}
+	switch dir {
+	case entity.DirUp:
+		sc.DrawRect(px+3, py-2, 8, 3, ColorShield)
+		sc.DrawRect(px+5, py-1, 4, 1, face)
+	case entity.DirDown:
+		sc.DrawRect(px+3, py+8, 8, 5, ColorShield)
+		sc.DrawRect(px+5, py+9, 4, 3, face)
+	case entity.DirLeft:
+		sc.DrawRect(px-1, py+4, 3, 8, ColorShield)
+		sc.DrawRect(px, py+6, 1, 4, face)
+	case entity.DirRight:
+		sc.DrawRect(px+12, py+4, 3, 8, ColorShield)
+		sc.DrawRect(px+13, py+6, 1, 4, face)
 	}
 }
 
@@ -71,6 +146,13 @@ func drawSword(sc *ScaledCanvas, px, py int, dir entity.Direction, progress floa
 	}
 }
 
+// drawWaterRipple draws a ring of ripples around a swimming player.
+func drawWaterRipple(sc *ScaledCanvas, px, py int) {
+	sc.DrawLine(px, py+1, px+3, py, ColorWaterLt)
+	sc.DrawLine(px+10, py, px+13, py+1, ColorWaterLt)
+	sc.DrawLine(px+3, py+3, px+10, py+3, ColorWaterLt)
+}
+
 func drawPlayerDown(sc *ScaledCanvas, px, py, legOff int) {
 	// Hat
 	sc.DrawRect(px+2, py, 10, 4, ColorHat)
@@ -6,7 +6,14 @@ import (
 	"github.com/AchrafSoltani/glow"
 )
 
-var ColorProjectile = glow.RGB(255, 100, 50)
+var (
+	ColorProjectile       = glow.RGB(255, 100, 50)
+	ColorProjectileStrong = glow.RGB(200, 80, 255)
+	ColorArrowShaft       = glow.RGB(160, 110, 50)
+	ColorArrowHead        = glow.RGB(200, 200, 220)
+	ColorFireball         = glow.RGB(255, 120, 30)
+	ColorFireballCore     = glow.RGB(255, 230, 120)
+)
 
 // DrawProjectile renders a small diamond-shaped projectile.
 func DrawProjectile(sc *ScaledCanvas, proj *entity.Projectile) {
@@ -21,8 +28,52 @@ func DrawProjectileAt(sc *ScaledCanvas, proj *entity.Projectile, offsetX, offset
 	px := int(proj.X) + offsetX
 	py := int(proj.Y) + config.HUDHeight + offsetY
 
+	if proj.IsArrow() {
+		drawArrow(sc, proj, px, py)
+		return
+	}
+	if proj.Kind == entity.ProjectileFireball {
+		// Flicker as it flies
+		flick := int(proj.X+proj.Y) / 4 % 2
+		sc.FillCircle(px+3, py+3, 3+flick, ColorFireball)
+		sc.FillCircle(px+3, py+3, 1+flick, ColorFireballCore)
+		return
+	}
+
+	color := ColorProjectile
+	if proj.Strong {
+		color = ColorProjectileStrong
+	}
+
 	// 4×4 diamond
-	sc.SetPixel(px+1, py, ColorProjectile)
-	sc.DrawRect(px, py+1, 4, 2, ColorProjectile)
-	sc.SetPixel(px+1, py+3, ColorProjectile)
+	sc.SetPixel(px+1, py, color)
+	sc.DrawRect(px, py+1, 4, 2, color)
+	sc.SetPixel(px+1, py+3, color)
+}
+
+// drawArrow draws an arrow pointing along its flight, with a bomb lashed to
+// the head for bomb-arrows.
+func drawArrow(sc *ScaledCanvas, proj *entity.Projectile, px, py int) {
+	w, h := proj.Width, proj.Height
+	sc.DrawRect(px, py+h/2, w, 1, ColorArrowShaft)
+	if h > w {
+		sc.DrawRect(px+w/2, py, 1, h, ColorArrowShaft)
+	}
+
+	// Head at the leading end
+	hx, hy := px+w/2, py+h/2
+	switch {
+	case proj.DirX > 0:
+		hx = px + w - 1
+	case proj.DirX < 0:
+		hx = px
+	case proj.DirY > 0:
+		hy = py + h - 1
+	case proj.DirY < 0:
+		hy = py
+	}
+	sc.DrawRect(hx-1, hy-1, 3, 3, ColorArrowHead)
+	if proj.Kind == entity.ProjectileBombArrow {
+		sc.FillCircle(hx, hy, 2, ColorBomb)
+	}
 }
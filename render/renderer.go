@@ -7,21 +7,22 @@ import (
 	"github.com/AchrafSoltani/glow"
 )
 
-func DrawScreen(sc *ScaledCanvas, screen *world.Screen) {
-	DrawScreenAt(sc, screen, 0, 0)
+func DrawScreen(sc *ScaledCanvas, screen *world.Screen, animTime float64) {
+	DrawScreenAt(sc, screen, 0, 0, animTime)
 }
 
 // DrawScreenAt draws a screen with a pixel offset (for scrolling transitions).
-func DrawScreenAt(sc *ScaledCanvas, screen *world.Screen, offsetX, offsetY int) {
+// animTime is the clock animated tiles such as torch flames run on.
+func DrawScreenAt(sc *ScaledCanvas, screen *world.Screen, offsetX, offsetY int, animTime float64) {
 	for gy := 0; gy < config.ScreenGridH; gy++ {
 		for gx := 0; gx < config.ScreenGridW; gx++ {
-			drawTileAt(sc, screen.Tiles[gy][gx], gx, gy, offsetX, offsetY)
+			drawTileAt(sc, screen.Tiles[gy][gx], gx, gy, offsetX, offsetY, animTime)
 		}
 	}
 }
 
 // DrawTransition renders both old and new screens sliding during a transition.
-func DrawTransition(sc *ScaledCanvas, oldScreen, newScreen *world.Screen, player *entity.Player, dirX, dirY int, easeProgress float64) {
+func DrawTransition(sc *ScaledCanvas, oldScreen, newScreen *world.Screen, player *entity.Player, dirX, dirY int, easeProgress, animTime float64) {
 	totalX := dirX * config.PlayAreaWidth
 	totalY := dirY * config.PlayAreaHeight
 
@@ -31,8 +32,8 @@ func DrawTransition(sc *ScaledCanvas, oldScreen, newScreen *world.Screen, player
 	newOffX := totalX + oldOffX
 	newOffY := totalY + oldOffY
 
-	DrawScreenAt(sc, oldScreen, oldOffX, oldOffY)
-	DrawScreenAt(sc, newScreen, newOffX, newOffY)
+	DrawScreenAt(sc, oldScreen, oldOffX, oldOffY, animTime)
+	DrawScreenAt(sc, newScreen, newOffX, newOffY, animTime)
 	DrawPlayerAt(sc, player, newOffX, newOffY)
 }
 
@@ -79,11 +80,11 @@ func DrawFlash(sc *ScaledCanvas, intensity float64) {
 	}
 }
 
-func drawTile(sc *ScaledCanvas, tile world.TileType, gx, gy int) {
-	drawTileAt(sc, tile, gx, gy, 0, 0)
+func drawTile(sc *ScaledCanvas, tile world.TileType, gx, gy int, animTime float64) {
+	drawTileAt(sc, tile, gx, gy, 0, 0, animTime)
 }
 
-func drawTileAt(sc *ScaledCanvas, tile world.TileType, gx, gy int, offsetX, offsetY int) {
+func drawTileAt(sc *ScaledCanvas, tile world.TileType, gx, gy int, offsetX, offsetY int, animTime float64) {
 	ts := config.TileSize
 	px := gx*ts + offsetX
 	py := gy*ts + config.HUDHeight + offsetY
@@ -155,9 +156,22 @@ func drawTileAt(sc *ScaledCanvas, tile world.TileType, gx, gy int, offsetX, offs
 		sc.SetPixel(px+10, py+8, ColorWaterLt)
 
 	case world.TileCliffN, world.TileCliffS, world.TileCliffE, world.TileCliffW:
+		// The lip faces the side the ledge drops to
 		sc.DrawRect(px, py, ts, ts, ColorGrass)
-		sc.DrawRect(px, py, ts, 3, ColorCliff)
-		sc.DrawLine(px, py+2, px+ts-1, py+2, ColorCliffEdge)
+		switch tile {
+		case world.TileCliffN:
+			sc.DrawRect(px, py, ts, 3, ColorCliff)
+			sc.DrawLine(px, py+2, px+ts-1, py+2, ColorCliffEdge)
+		case world.TileCliffS:
+			sc.DrawRect(px, py+ts-3, ts, 3, ColorCliff)
+			sc.DrawLine(px, py+ts-3, px+ts-1, py+ts-3, ColorCliffEdge)
+		case world.TileCliffE:
+			sc.DrawRect(px+ts-3, py, 3, ts, ColorCliff)
+			sc.DrawLine(px+ts-3, py, px+ts-3, py+ts-1, ColorCliffEdge)
+		case world.TileCliffW:
+			sc.DrawRect(px, py, 3, ts, ColorCliff)
+			sc.DrawLine(px+2, py, px+2, py+ts-1, ColorCliffEdge)
+		}
 
 	case world.TileBridge:
 		sc.DrawRect(px, py, ts, ts, ColorBridge)
@@ -312,8 +326,10 @@ func drawTileAt(sc *ScaledCanvas, tile world.TileType, gx, gy int, offsetX, offs
 	case world.TileTorchLit:
 		sc.DrawRect(px, py, ts, ts, ColorFloor)
 		sc.DrawRect(px+5, py+6, 6, 8, ColorTorch)
-		sc.FillCircle(px+8, py+4, 3, ColorFlame)
-		sc.FillCircle(px+8, py+3, 2, glow.RGB(255, 220, 100))
+		// Flicker, out of step from one torch to the next
+		flick := int(animTime*10+float64(gx*3+gy*5)) % 3
+		sc.FillCircle(px+8, py+4-flick/2, 3-flick/2, ColorFlame)
+		sc.FillCircle(px+8+flick-1, py+3-flick/2, 2, glow.RGB(255, 220, 100))
 
 	case world.TileGrassFlower:
 		sc.DrawRect(px, py, ts, ts, ColorGrass)
@@ -355,6 +371,38 @@ func drawTileAt(sc *ScaledCanvas, tile world.TileType, gx, gy int, offsetX, offs
 		sc.DrawRect(px+2, py+5, 2, 7, ColorFence)
 		sc.DrawRect(px+12, py+5, 2, 7, ColorFence)
 
+	case world.TileShutter:
+		sc.DrawRect(px, py, ts, ts, ColorWall)
+		sc.DrawRect(px+2, py+2, ts-4, ts-4, ColorShutter)
+		sc.DrawLine(px+2, py+6, px+ts-3, py+6, ColorWallDark)
+		sc.DrawLine(px+2, py+10, px+ts-3, py+10, ColorWallDark)
+
+	case world.TileBlock:
+		sc.DrawRect(px, py, ts, ts, ColorFloor)
+		drawBlock(sc, px, py)
+
+	case world.TileBarrier:
+		sc.DrawRect(px, py, ts, ts, ColorBarrierDark)
+		sc.DrawRect(px+1, py+1, ts-2, ts-4, ColorBarrier)
+		sc.DrawLine(px+3, py+3, px+ts-4, py+3, ColorBlockLight)
+
+	case world.TileBarrierDown:
+		sc.DrawRect(px, py, ts, ts, ColorFloor)
+		sc.DrawRectOutline(px+1, py+1, ts-2, ts-2, ColorBarrierDark)
+
+	case world.TileHole:
+		sc.DrawRect(px, py, ts, ts, ColorPath)
+		sc.FillCircle(px+8, py+8, 6, ColorHole)
+		sc.FillCircle(px+8, py+9, 4, ColorHoleDark)
+
+	case world.TileSongDoor:
+		sc.DrawRect(px, py, ts, ts, ColorWall)
+		sc.DrawRect(px+2, py+2, ts-4, ts-4, ColorSongDoor)
+		// Music note carved in the door
+		sc.FillCircle(px+6, py+10, 2, ColorSongNote)
+		sc.DrawRect(px+7, py+4, 1, 6, ColorSongNote)
+		sc.DrawRect(px+8, py+4, 2, 1, ColorSongNote)
+
 	case world.TileFenceV:
 		sc.DrawRect(px, py, ts, ts, ColorGrass)
 		sc.DrawRect(px+5, py, 2, ts, ColorFence)
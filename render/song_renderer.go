@@ -0,0 +1,57 @@
+package render
+
+import (
+	"github.com/AchrafSoltani/GlowQuest/config"
+	"github.com/AchrafSoltani/GlowQuest/entity"
+)
+
+// DrawSongSelect draws the ocarina song picker. Only learned songs are
+// listed; the cursor marks the one to play.
+func DrawSongSelect(sc *ScaledCanvas, songs [3]bool, cursor int) {
+	boxW := 180
+	boxH := 72
+	boxX := (config.WindowWidth - boxW) / 2
+	boxY := (config.WindowHeight - boxH) / 2
+
+	sc.DrawRect(boxX, boxY, boxW, boxH, ColorInvBG)
+	sc.DrawRectOutline(boxX, boxY, boxW, boxH, ColorHUDText)
+
+	title := "OCARINA"
+	tw := TextWidth(title)
+	DrawText(sc, title, boxX+(boxW-tw)/2, boxY+4, ColorHUDText)
+
+	y := boxY + 18
+	for i, known := range songs {
+		if !known {
+			continue
+		}
+		color := ColorMenuDisabled
+		if i == cursor {
+			color = ColorHUDText
+			DrawText(sc, ">", boxX+6, y, ColorSongNote)
+		}
+		DrawText(sc, entity.SongName(entity.SongID(i)), boxX+16, y, color)
+		y += 12
+	}
+
+	inst := "SPACE:PLAY  ESC:BACK"
+	iw := TextWidth(inst)
+	DrawText(sc, inst, boxX+(boxW-iw)/2, boxY+boxH-10, ColorMenuDisabled)
+}
+
+// DrawSongNotes draws music notes drifting up from the player while a song
+// plays.
+func DrawSongNotes(sc *ScaledCanvas, p *entity.Player, timer float64, offsetX, offsetY int) {
+	px := int(p.X) + offsetX
+	py := int(p.Y) + config.HUDHeight + offsetY
+
+	for i := 0; i < 3; i++ {
+		// Each note rises over a second, staggered by a third of a second
+		t := timer + float64(i)/3
+		rise := int((t - float64(int(t))) * 16)
+		nx := px + 2 + i*5
+		ny := py - 4 - rise
+		sc.FillCircle(nx, ny+4, 1, ColorSongNote)
+		sc.DrawRect(nx+1, ny, 1, 4, ColorSongNote)
+	}
+}
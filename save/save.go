@@ -11,6 +11,26 @@ type QuestSaveData struct {
 	Flags             map[string]bool `json:"flags,omitempty"`
 	DungeonsCompleted [9]bool         `json:"dungeons_completed"`
 	TradingItem       int             `json:"trading_item"`
+	HeartPieces       []string        `json:"heart_pieces,omitempty"`
+	WarpPoints        []string        `json:"warp_points,omitempty"`
+}
+
+// DungeonSaveData holds serializable progress for one dungeon.
+type DungeonSaveData struct {
+	MapID           string          `json:"map_id"`
+	SmallKeys       int             `json:"small_keys"`
+	HasMap          bool            `json:"has_map,omitempty"`
+	HasCompass      bool            `json:"has_compass,omitempty"`
+	HasStoneBeak    bool            `json:"has_stone_beak,omitempty"`
+	HasNightmareKey bool            `json:"has_nightmare_key,omitempty"`
+	HasDungeonItem  bool            `json:"has_dungeon_item,omitempty"`
+	VisitedRooms    [][2]int        `json:"visited_rooms,omitempty"`
+	OpenedChests    map[string]bool `json:"opened_chests,omitempty"`
+	ClearedRooms    [][2]int        `json:"cleared_rooms,omitempty"`
+	OpenedDoors     map[string]bool `json:"opened_doors,omitempty"`
+
+	PressedSwitches map[string]bool     `json:"pressed_switches,omitempty"`
+	BlockSpots      map[string][][2]int `json:"block_spots,omitempty"`
 }
 
 type SaveData struct {
@@ -22,6 +42,8 @@ type SaveData struct {
 	Keys           int             `json:"keys"`
 	CollectedItems map[string]bool `json:"collected_items"`
 	UnlockedDoors  map[string]bool `json:"unlocked_doors"`
+	BombedWalls    map[string]bool `json:"bombed_walls,omitempty"`
+	SongDoors      map[string]bool `json:"song_doors,omitempty"`
 	ScreenX        int             `json:"screen_x"`
 	ScreenY        int             `json:"screen_y"`
 	PlayerX        float64         `json:"player_x"`
@@ -33,6 +55,7 @@ type SaveData struct {
 	// V2 fields
 	Bombs         int             `json:"bombs,omitempty"`
 	Arrows        int             `json:"arrows,omitempty"`
+	Powder        int             `json:"powder,omitempty"`
 	SwordLevel    int             `json:"sword_level,omitempty"`
 	ShieldLevel   int             `json:"shield_level,omitempty"`
 	BraceletLevel int             `json:"bracelet_level,omitempty"`
@@ -44,6 +67,7 @@ type SaveData struct {
 	DungeonRoomX  int             `json:"dungeon_room_x,omitempty"`
 	DungeonRoomY  int             `json:"dungeon_room_y,omitempty"`
 	Quest         *QuestSaveData  `json:"quest,omitempty"`
+	Dungeons      [9]*DungeonSaveData `json:"dungeons"`
 }
 
 func savePath() string {
@@ -17,6 +17,14 @@ func ProximityCheck(px, py, nx, ny, radius float64) bool {
 }
 
 func TileCollision(screen *world.Screen, x, y float64, w, h int) bool {
+	return tileCollisionFunc(screen, x, y, w, h, func(tile world.TileType) bool {
+		return !world.TileProps[tile].Passable
+	})
+}
+
+// tileCollisionFunc checks a box against every tile it overlaps that the
+// blocked function rejects.
+func tileCollisionFunc(screen *world.Screen, x, y float64, w, h int, blocked func(world.TileType) bool) bool {
 	fw := float64(w)
 	fh := float64(h)
 	ts := float64(config.TileSize)
@@ -29,7 +37,7 @@ func TileCollision(screen *world.Screen, x, y float64, w, h int) bool {
 	for gy := startY; gy <= endY; gy++ {
 		for gx := startX; gx <= endX; gx++ {
 			tile := screen.TileAt(gx, gy)
-			if !world.TileProps[tile].Passable {
+			if blocked(tile) {
 				tileX := float64(gx) * ts
 				tileY := float64(gy) * ts
 				if AABBOverlap(x, y, fw, fh, tileX, tileY, ts, ts) {
@@ -3,6 +3,7 @@ package system
 import (
 	"github.com/AchrafSoltani/GlowQuest/config"
 	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/world"
 )
 
 // CheckSwordHits returns enemies hit by the player's active sword swing.
@@ -25,6 +26,38 @@ func CheckSwordHits(p *entity.Player, enemies []*entity.Enemy) []*entity.Enemy {
 	return hit
 }
 
+// CutWithSword cuts the bushes under an active sword swing and returns their
+// grid positions.
+func CutWithSword(p *entity.Player, screen *world.Screen) [][2]int {
+	if !p.Sword.Active {
+		return nil
+	}
+	sx, sy, sw, sh := p.Sword.HitBox(p.X, p.Y, p.Width, p.Height)
+	return breakCuttable(screen, sx, sy, sw, sh)
+}
+
+// CheckDashHits returns enemies struck by the sword held out in front of a
+// dashing player.
+func CheckDashHits(p *entity.Player, enemies []*entity.Enemy) []*entity.Enemy {
+	if !p.Dashing || !p.HasSword {
+		return nil
+	}
+
+	held := entity.SwordSwing{Dir: p.DashDir}
+	sx, sy, sw, sh := held.HitBox(p.X, p.Y, p.Width, p.Height)
+	var hit []*entity.Enemy
+
+	for _, e := range enemies {
+		if e.Dead || e.InvTimer > 0 {
+			continue
+		}
+		if AABBOverlap(sx, sy, sw, sh, e.X, e.Y, float64(e.Width), float64(e.Height)) {
+			hit = append(hit, e)
+		}
+	}
+	return hit
+}
+
 // ApplyKnockback starts a knockback effect on an enemy, pushing away from the player.
 func ApplyKnockback(e *entity.Enemy, fromX, fromY float64) {
 	dx := e.CenterX() - fromX
@@ -96,6 +129,54 @@ func CheckProjectileSwordCollision(p *entity.Player, proj *entity.Projectile) bo
 	return AABBOverlap(sx, sy, sw, sh, proj.X, proj.Y, float64(proj.Width), float64(proj.Height))
 }
 
+// ShieldFacesPoint returns true if the player's raised shield covers a point:
+// it must lie within the cone in front of the player.
+func ShieldFacesPoint(p *entity.Player, x, y float64) bool {
+	if !p.Shielding {
+		return false
+	}
+	dx := x - p.CenterX()
+	dy := y - p.CenterY()
+	dist := sqrt(dx*dx + dy*dy)
+	if dist < 0.01 {
+		return false
+	}
+	return (dx*p.Dir.DX()+dy*p.Dir.DY())/dist > config.ShieldCone
+}
+
+// ShieldBlocksProjectile returns true if the raised shield stops a projectile
+// flying at the player's front. Strong projectiles need the mirror shield.
+func ShieldBlocksProjectile(p *entity.Player, proj *entity.Projectile) bool {
+	if proj.Strong && p.Inventory.ShieldLevel < 2 {
+		return false
+	}
+	if proj.DirX*p.Dir.DX()+proj.DirY*p.Dir.DY() >= 0 {
+		return false
+	}
+	return ShieldFacesPoint(p, proj.X+float64(proj.Width)/2, proj.Y+float64(proj.Height)/2)
+}
+
+// ReflectProjectile sends a projectile back the way it came as a player shot.
+func ReflectProjectile(proj *entity.Projectile) {
+	proj.DirX = -proj.DirX
+	proj.DirY = -proj.DirY
+	proj.FromEnemy = false
+}
+
+// CheckProjectileEnemyHits returns the first enemy a player projectile overlaps.
+func CheckProjectileEnemyHits(proj *entity.Projectile, enemies []*entity.Enemy) *entity.Enemy {
+	for _, e := range enemies {
+		if e.Dead || e.InvTimer > 0 {
+			continue
+		}
+		if AABBOverlap(proj.X, proj.Y, float64(proj.Width), float64(proj.Height),
+			e.X, e.Y, float64(e.Width), float64(e.Height)) {
+			return e
+		}
+	}
+	return nil
+}
+
 func sqrt(x float64) float64 {
 	if x <= 0 {
 		return 0
@@ -26,10 +26,16 @@ func UpdateEnemyAI(e *entity.Enemy, p *entity.Player, screen *world.Screen, dt f
 		return nil
 	}
 
+	// Stunned enemies stand still
+	if e.StunTimer > 0 {
+		e.StunTimer -= dt
+		return nil
+	}
+
 	e.AITimer -= dt
 
 	switch e.Type {
-	case entity.EnemyOctorok:
+	case entity.EnemyOctorok, entity.EnemyIceOctorok:
 		return updateOctorok(e, p, screen, dt, rng)
 	case entity.EnemyMoblin:
 		updateMoblin(e, p, screen, dt, rng)
@@ -149,31 +155,46 @@ func chasePlayer(e *entity.Enemy, p *entity.Player) {
 	}
 }
 
+// moveEnemy walks an enemy along its facing. Ground enemies follow the
+// same tile physics as the player: slow tiles, ice and conveyors.
 func moveEnemy(e *entity.Enemy, screen *world.Screen, dt float64) {
-	if !e.Moving {
-		e.UpdateAnimation(dt)
-		return
+	props := world.TileProperties{SlowFactor: 1.0}
+	if !EnemyFlies(e.Type) {
+		props = TilePropsAt(screen, e.CenterX(), e.CenterY())
 	}
 
-	dx := e.Dir.DX()
-	dy := e.Dir.DY()
-	dist := e.Speed * dt
+	var vx, vy float64
+	if e.Moving {
+		vx = e.Dir.DX() * e.Speed * props.SlowFactor
+		vy = e.Dir.DY() * e.Speed * props.SlowFactor
+	}
+	if props.Slippery {
+		vx, vy = slide(e.VelX, e.VelY, vx, vy, dt)
+	}
+	e.VelX, e.VelY = vx, vy
+	cx, cy := ConveyorVector(props.ConveyorDir)
+	vx += cx * config.ConveyorSpeed
+	vy += cy * config.ConveyorSpeed
 
 	// Try X
-	if dx != 0 {
-		newX := e.X + dx*dist
+	if vx != 0 {
+		newX := e.X + vx*dt
 		if newX >= 0 && newX+float64(e.Width) <= float64(config.PlayAreaWidth) &&
 			!TileCollision(screen, newX, e.Y, e.Width, e.Height) {
 			e.X = newX
+		} else {
+			e.VelX = 0
 		}
 	}
 
 	// Try Y
-	if dy != 0 {
-		newY := e.Y + dy*dist
+	if vy != 0 {
+		newY := e.Y + vy*dt
 		if newY >= 0 && newY+float64(e.Height) <= float64(config.PlayAreaHeight) &&
 			!TileCollision(screen, e.X, newY, e.Width, e.Height) {
 			e.Y = newY
+		} else {
+			e.VelY = 0
 		}
 	}
 
@@ -264,7 +285,12 @@ func updateBoss(e *entity.Enemy, p *entity.Player, screen *world.Screen, dt floa
 			e.BurstCount++
 			e.AITimer = 0.3
 			e.UpdateAnimation(dt)
-			return fireAtPlayer(e, p)
+			// Boss shots are strong: only the mirror shield stops them
+			proj := fireAtPlayer(e, p)
+			if proj != nil {
+				proj.Strong = true
+			}
+			return proj
 		}
 		if e.BurstCount >= 4 {
 			e.AIState = 0
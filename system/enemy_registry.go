@@ -1,6 +1,9 @@
 package system
 
-import "github.com/AchrafSoltani/GlowQuest/entity"
+import (
+	"github.com/AchrafSoltani/GlowQuest/entity"
+	"github.com/AchrafSoltani/GlowQuest/world"
+)
 
 // AIType identifies the AI behaviour pattern for an enemy.
 type AIType int
@@ -15,6 +18,15 @@ const (
 	AIStationary               // doesn't move
 )
 
+// PowderEffect is what sprinkling Magic Powder does to an enemy.
+type PowderEffect int
+
+const (
+	PowderNone      PowderEffect = iota // no reaction
+	PowderFairy                         // turns into a fairy
+	PowderTransform                     // turns into the weaker PowderInto enemy
+)
+
 // EnemyDef is a data-driven enemy definition.
 type EnemyDef struct {
 	Type      entity.EnemyType
@@ -27,6 +39,10 @@ type EnemyDef struct {
 	ChaseRange float64 // distance to start chasing (0 = always)
 	ShootRate  float64 // seconds between shots (0 = no shooting)
 	ContactDmg int    // damage on contact (default 1)
+	Flying     bool   // airborne: a jumping player can't pass over it
+	Icy        bool   // made of ice: fire destroys it outright
+	Powder     PowderEffect
+	PowderInto entity.EnemyType
 }
 
 // EnemyRegistry holds definitions for all enemy types.
@@ -36,15 +52,22 @@ var EnemyRegistry = map[entity.EnemyType]*EnemyDef{
 		Width: 14, Height: 14, HP: 2, Speed: 30,
 		AI: AIShooter, ShootRate: 2.0, ContactDmg: 1,
 	},
+	entity.EnemyIceOctorok: {
+		Type: entity.EnemyIceOctorok, Name: "Ice Octorok",
+		Width: 14, Height: 14, HP: 6, Speed: 25,
+		AI: AIShooter, ShootRate: 2.5, ContactDmg: 1, Icy: true,
+	},
 	entity.EnemyMoblin: {
 		Type: entity.EnemyMoblin, Name: "Moblin",
 		Width: 14, Height: 14, HP: 3, Speed: 35,
 		AI: AIChase, ChaseRange: 80, ContactDmg: 1,
+		Powder: PowderTransform, PowderInto: entity.EnemyOctorok,
 	},
 	entity.EnemyStalfos: {
 		Type: entity.EnemyStalfos, Name: "Stalfos",
 		Width: 14, Height: 14, HP: 2, Speed: 45,
 		AI: AIChase, ChaseRange: 48, ContactDmg: 1,
+		Powder: PowderFairy,
 	},
 	entity.EnemyBoss: {
 		Type: entity.EnemyBoss, Name: "Boss",
@@ -55,7 +78,8 @@ var EnemyRegistry = map[entity.EnemyType]*EnemyDef{
 	entity.EnemyKeese: {
 		Type: entity.EnemyKeese, Name: "Keese",
 		Width: 12, Height: 12, HP: 1, Speed: 50,
-		AI: AIBounce, ContactDmg: 1,
+		AI: AIBounce, ContactDmg: 1, Flying: true,
+		Powder: PowderFairy,
 	},
 	entity.EnemyGel: {
 		Type: entity.EnemyGel, Name: "Gel",
@@ -83,3 +107,33 @@ var EnemyRegistry = map[entity.EnemyType]*EnemyDef{
 func GetEnemyDef(t entity.EnemyType) *EnemyDef {
 	return EnemyRegistry[t]
 }
+
+// EnemyIsIcy returns true for ice enemies that fire kills in one hit.
+func EnemyIsIcy(t entity.EnemyType) bool {
+	def := EnemyRegistry[t]
+	return def != nil && def.Icy
+}
+
+// EnemyPowder returns how an enemy type reacts to Magic Powder.
+func EnemyPowder(t entity.EnemyType) (PowderEffect, entity.EnemyType) {
+	def := EnemyRegistry[t]
+	if def == nil {
+		return PowderNone, t
+	}
+	return def.Powder, def.PowderInto
+}
+
+// EnemyOverPit returns true if a ground enemy's centre is over a pit or
+// lava.
+func EnemyOverPit(e *entity.Enemy, screen *world.Screen) bool {
+	if EnemyFlies(e.Type) || e.Type == entity.EnemyBoss {
+		return false
+	}
+	return TilePropsAt(screen, e.CenterX(), e.CenterY()).Fall
+}
+
+// EnemyFlies returns true if an enemy type is airborne.
+func EnemyFlies(t entity.EnemyType) bool {
+	def := EnemyRegistry[t]
+	return def != nil && def.Flying
+}
@@ -6,33 +6,81 @@ import "github.com/AchrafSoltani/GlowQuest/entity"
 type ItemUseResult struct {
 	UsedItem   entity.EquipItemID
 	SwordSwing bool // trigger a sword swing
-	// Future: Jump, Dash, Lift, Shoot, etc.
+	Shield     bool // raise the shield
+	Jump       bool // start a Roc's Feather jump
+	Dash       bool // start charging a Pegasus Boots dash
+	PlaceBomb  bool // set down a lit bomb
+	ShootArrow bool // fire an arrow
+	Lift       bool // pick up the rock or pot in front
+	Hookshot   bool // fire the hookshot
+	Boomerang  bool // throw the boomerang
+	Fire       bool // cast a Magic Rod fireball
+	Ocarina    bool // open the song picker
+	Dig        bool // dig with the shovel
+	Powder     bool // sprinkle magic powder
+	Dive       bool // dive under the water
 }
 
 // UseItem processes the activation of an equipped item.
 // Returns what action should be taken by the game.
 func UseItem(item entity.EquipItemID, p *entity.Player) ItemUseResult {
+	// Swimming leaves the hands free for nothing but diving
+	if p.Swimming {
+		if !p.Diving {
+			return ItemUseResult{UsedItem: item, Dive: true}
+		}
+		return ItemUseResult{}
+	}
+
 	switch item {
 	case entity.EquipSword:
 		if p.Inventory.SwordLevel > 0 || p.HasSword {
 			return ItemUseResult{UsedItem: item, SwordSwing: true}
 		}
 	case entity.EquipShield:
-		// Shield blocking — will be implemented in Phase 7
+		if p.Inventory.ShieldLevel > 0 {
+			return ItemUseResult{UsedItem: item, Shield: true}
+		}
 	case entity.EquipRocsFeather:
-		// Jump — will be implemented in Phase 7
+		if !p.Jumping {
+			return ItemUseResult{UsedItem: item, Jump: true}
+		}
 	case entity.EquipPegasusBoots:
-		// Dash — will be implemented in Phase 12
+		if !p.Dashing && !p.DashCharging {
+			return ItemUseResult{UsedItem: item, Dash: true}
+		}
 	case entity.EquipBomb:
-		// Place bomb — will be implemented in Phase 12
+		if p.Inventory.Bombs > 0 && !p.Lifting {
+			return ItemUseResult{UsedItem: item, PlaceBomb: true}
+		}
 	case entity.EquipBow:
-		// Shoot arrow — will be implemented later
+		if p.Inventory.Arrows > 0 {
+			return ItemUseResult{UsedItem: item, ShootArrow: true}
+		}
 	case entity.EquipPowerBracelet:
-		// Lift — will be implemented in Phase 10
+		if p.Inventory.BraceletLevel > 0 && !p.Lifting {
+			return ItemUseResult{UsedItem: item, Lift: true}
+		}
 	case entity.EquipHookshot:
-		// Grapple — will be implemented in Phase 16
+		if !p.Lifting {
+			return ItemUseResult{UsedItem: item, Hookshot: true}
+		}
+	case entity.EquipBoomerang:
+		if !p.Lifting {
+			return ItemUseResult{UsedItem: item, Boomerang: true}
+		}
+	case entity.EquipShovel:
+		if !p.Lifting && !p.Jumping {
+			return ItemUseResult{UsedItem: item, Dig: true}
+		}
+	case entity.EquipMagicPowder:
+		if p.Inventory.Powder > 0 && !p.Lifting {
+			return ItemUseResult{UsedItem: item, Powder: true}
+		}
+	case entity.EquipOcarina:
+		return ItemUseResult{UsedItem: item, Ocarina: true}
 	case entity.EquipMagicRod:
-		// Fire — will be implemented in Phase 20
+		return ItemUseResult{UsedItem: item, Fire: true}
 	}
 	return ItemUseResult{}
 }
@@ -9,26 +9,168 @@ import (
 // MovePlayer moves the player and returns an edge-crossing signal.
 // Returns (0,0) normally. Returns (-1,0)/(1,0)/(0,-1)/(0,1) if the
 // player walked off the left/right/top/bottom edge respectively.
+// The tile underfoot scales the speed, ice keeps the player sliding and
+// conveyors carry them along unless they are in the air.
 func MovePlayer(p *entity.Player, screen *world.Screen, dx, dy float64, dt float64) (crossX, crossY int) {
-	dist := p.Speed * dt
+	props := TilePropsAt(screen, p.CenterX(), p.CenterY())
+	speed := p.Speed * props.SlowFactor
+	if p.Lifting {
+		speed *= config.CarrySpeedMul
+	}
+
+	vx, vy := dx*speed, dy*speed
+	if props.Slippery {
+		vx, vy = slide(p.VelX, p.VelY, vx, vy, dt)
+	}
+	p.VelX, p.VelY = vx, vy
+	if !p.Jumping {
+		cx, cy := ConveyorVector(props.ConveyorDir)
+		vx += cx * config.ConveyorSpeed
+		vy += cy * config.ConveyorSpeed
+	}
 
 	// Try X axis
-	if dx != 0 {
-		newX := p.X + dx*dist
-		if !TileCollision(screen, newX, p.Y, p.Width, p.Height) {
+	if vx != 0 {
+		newX := p.X + vx*dt
+		if !playerCollision(screen, p, newX, p.Y, vx, 0) {
 			p.X = newX
+		} else {
+			p.VelX = 0
 		}
 	}
 
 	// Try Y axis
-	if dy != 0 {
-		newY := p.Y + dy*dist
-		if !TileCollision(screen, p.X, newY, p.Width, p.Height) {
+	if vy != 0 {
+		newY := p.Y + vy*dt
+		if !playerCollision(screen, p, p.X, newY, 0, vy) {
 			p.Y = newY
+		} else {
+			p.VelY = 0
+		}
+	}
+
+	crossX, crossY = edgeCrossing(p)
+	return crossX, crossY
+}
+
+// TilePropsAt returns the properties of the tile under a pixel position.
+func TilePropsAt(screen *world.Screen, x, y float64) world.TileProperties {
+	ts := config.TileSize
+	return world.TileProps[screen.TileAt(int(x)/ts, int(y)/ts)]
+}
+
+// ConveyorVector returns the unit direction a conveyor moves things in, or
+// zero for no conveyor.
+func ConveyorVector(dir int) (float64, float64) {
+	switch dir {
+	case 1:
+		return 0, -1
+	case 2:
+		return 0, 1
+	case 3:
+		return 1, 0
+	case 4:
+		return -1, 0
+	}
+	return 0, 0
+}
+
+// slide eases a velocity towards the one input asks for, so movement on ice
+// builds up and dies away slowly.
+func slide(vx, vy, wantX, wantY, dt float64) (float64, float64) {
+	k := config.IceGrip * dt
+	if k > 1 {
+		k = 1
+	}
+	return vx + (wantX-vx)*k, vy + (wantY-vy)*k
+}
+
+// KnockbackPlayer shoves the player away from a point. A push from dead
+// centre sends them back the way they face.
+func KnockbackPlayer(p *entity.Player, fromX, fromY float64) {
+	dx := p.CenterX() - fromX
+	dy := p.CenterY() - fromY
+	dist := dx*dx + dy*dy
+	if dist < 0.01 {
+		dx, dy = -p.Dir.DX(), -p.Dir.DY()
+	} else {
+		inv := 1.0 / sqrt(dist)
+		dx *= inv
+		dy *= inv
+	}
+	p.KnockbackX = dx * config.KnockbackDist / config.KnockbackTime
+	p.KnockbackY = dy * config.KnockbackDist / config.KnockbackTime
+	p.KnockbackTimer = config.KnockbackTime
+	p.VelX, p.VelY = 0, 0
+	p.StopDash()
+}
+
+// UpdatePlayerKnockback moves a knocked-back player, stopping at walls and
+// the edges of the play area.
+func UpdatePlayerKnockback(p *entity.Player, screen *world.Screen, dt float64) {
+	if p.KnockbackTimer <= 0 {
+		return
+	}
+	newX := p.X + p.KnockbackX*dt
+	if newX >= 0 && newX+float64(p.Width) <= config.PlayAreaWidth &&
+		!playerCollision(screen, p, newX, p.Y, p.KnockbackX, 0) {
+		p.X = newX
+	}
+	newY := p.Y + p.KnockbackY*dt
+	if newY >= 0 && newY+float64(p.Height) <= config.PlayAreaHeight &&
+		!playerCollision(screen, p, p.X, newY, 0, p.KnockbackY) {
+		p.Y = newY
+	}
+	p.KnockbackTimer -= dt
+	if p.KnockbackTimer <= 0 {
+		p.KnockbackTimer = 0
+		p.KnockbackX = 0
+		p.KnockbackY = 0
+	}
+}
+
+// DashPlayer carries a dashing player along DashDir, breaking cuttable tiles
+// in the way. Returns any edge crossing, the tiles broken, and whether the
+// dash ran into something solid.
+func DashPlayer(p *entity.Player, screen *world.Screen, dt float64) (crossX, crossY int, broken [][2]int, hitWall bool) {
+	dx, dy := p.DashDir.DX(), p.DashDir.DY()
+	dist := config.DashSpeed * dt
+	newX := p.X + dx*dist
+	newY := p.Y + dy*dist
+
+	broken = breakCuttable(screen, newX, newY, float64(p.Width), float64(p.Height))
+	if playerCollision(screen, p, newX, newY, dx, dy) {
+		hitWall = true
+	} else {
+		p.X = newX
+		p.Y = newY
+	}
+
+	crossX, crossY = edgeCrossing(p)
+	return crossX, crossY, broken, hitWall
+}
+
+// breakCuttable cuts every cuttable tile the box overlaps and returns their
+// grid positions.
+func breakCuttable(screen *world.Screen, x, y, w, h float64) [][2]int {
+	ts := float64(config.TileSize)
+	var broken [][2]int
+	for gy := int(y / ts); gy <= int((y+h-0.01)/ts); gy++ {
+		for gx := int(x / ts); gx <= int((x+w-0.01)/ts); gx++ {
+			if gx < 0 || gx >= config.ScreenGridW || gy < 0 || gy >= config.ScreenGridH {
+				continue
+			}
+			if world.TileProps[screen.Tiles[gy][gx]].Cuttable {
+				screen.CutTile(gx, gy)
+				broken = append(broken, [2]int{gx, gy})
+			}
 		}
 	}
+	return broken
+}
 
-	// Check edge crossings
+// edgeCrossing reports which play-area edge, if any, the player is past.
+func edgeCrossing(p *entity.Player) (crossX, crossY int) {
 	if p.X < 0 {
 		crossX = -1
 	} else if p.X+float64(p.Width) > float64(config.PlayAreaWidth) {
@@ -42,3 +184,104 @@ func MovePlayer(p *entity.Player, screen *world.Screen, dx, dy float64, dt float
 
 	return crossX, crossY
 }
+
+// PlayerBlocked returns true if the player can't stand at (x, y) while
+// moving along (dx, dy).
+func PlayerBlocked(p *entity.Player, screen *world.Screen, x, y, dx, dy float64) bool {
+	return playerCollision(screen, p, x, y, dx, dy)
+}
+
+// playerCollision checks the player's box at (x, y) moving along (dx, dy).
+// A jumping player clears pits, damaging tiles and ledges in the direction
+// they drop. Water is open to a player with Flippers, and deep water lets
+// anyone wade in to drown. Anyone can walk into a pit or lava and fall.
+func playerCollision(screen *world.Screen, p *entity.Player, x, y, dx, dy float64) bool {
+	return tileCollisionFunc(screen, x, y, p.Width, p.Height, func(tile world.TileType) bool {
+		props := world.TileProps[tile]
+		if props.Passable {
+			return false
+		}
+		if props.Swimmable && (tile == world.TileWater || p.Inventory.OwnedItems[entity.EquipFlippers]) {
+			return false
+		}
+		if props.Fall {
+			return false
+		}
+		if !p.Jumping {
+			return true
+		}
+		if tile == world.TilePit || props.Damaging {
+			return false
+		}
+		if props.JumpDown {
+			lx, ly := LedgeDir(tile)
+			return dx*lx+dy*ly <= 0
+		}
+		return true
+	})
+}
+
+// FacingLedge returns true if the player is up against a ledge that drops
+// the way they face, ready to hop down it.
+func FacingLedge(p *entity.Player, screen *world.Screen) bool {
+	dx, dy := p.Dir.DX(), p.Dir.DY()
+	x, y := p.X+dx, p.Y+dy
+	ledge := tileCollisionFunc(screen, x, y, p.Width, p.Height, func(tile world.TileType) bool {
+		if !world.TileProps[tile].JumpDown {
+			return false
+		}
+		lx, ly := LedgeDir(tile)
+		return lx == dx && ly == dy
+	})
+	if !ledge {
+		return false
+	}
+	// Nothing else may stand in the way of the hop
+	return !tileCollisionFunc(screen, x, y, p.Width, p.Height, func(tile world.TileType) bool {
+		props := world.TileProps[tile]
+		return !props.Passable && !props.JumpDown
+	})
+}
+
+// LedgeDir returns the unit direction a cliff ledge drops towards.
+func LedgeDir(tile world.TileType) (float64, float64) {
+	switch world.TileProps[tile].JumpDir {
+	case 0:
+		return 0, -1
+	case 1:
+		return 0, 1
+	case 2:
+		return 1, 0
+	default:
+		return -1, 0
+	}
+}
+
+// LandPlayer settles the player at the end of a jump. Coming down over a
+// ledge carries them on past it; anywhere else they can't stand puts them
+// back where the jump took off.
+func LandPlayer(p *entity.Player, screen *world.Screen) {
+	if !TileCollision(screen, p.X, p.Y, p.Width, p.Height) {
+		return
+	}
+
+	ts := float64(config.TileSize)
+	for _, c := range [][2]float64{{p.X, p.Y}, {p.X + float64(p.Width) - 1, p.Y + float64(p.Height) - 1}} {
+		tile := screen.TileAt(int(c[0]/ts), int(c[1]/ts))
+		if !world.TileProps[tile].JumpDown {
+			continue
+		}
+		lx, ly := LedgeDir(tile)
+		for i := 0; i < config.TileSize*2; i++ {
+			p.X += lx
+			p.Y += ly
+			if !TileCollision(screen, p.X, p.Y, p.Width, p.Height) {
+				return
+			}
+		}
+		break
+	}
+
+	p.X = p.JumpStartX
+	p.Y = p.JumpStartY
+}
@@ -1,5 +1,7 @@
 package world
 
+import "fmt"
+
 // DungeonID identifies a dungeon.
 type DungeonID int
 
@@ -18,6 +20,7 @@ const (
 // Dungeon represents a multi-room dungeon.
 type Dungeon struct {
 	ID          DungeonID
+	MapID       string // file name under maps/dungeons, used by "dungeon:ID" warps
 	Name        string
 	GridW       int
 	GridH       int
@@ -37,6 +40,9 @@ type Dungeon struct {
 	OpenedChests  map[string]bool
 	ClearedRooms  map[[2]int]bool
 	OpenedDoors   map[string]bool
+
+	PressedSwitches map[string]bool     // SwitchKey of latched and toggled-on switches
+	BlockSpots      map[string][][2]int // RoomKey -> where the room's push blocks rest
 }
 
 // NewDungeon creates an empty dungeon structure.
@@ -51,6 +57,9 @@ func NewDungeon(id DungeonID, name string, w, h int) *Dungeon {
 		OpenedChests: make(map[string]bool),
 		ClearedRooms: make(map[[2]int]bool),
 		OpenedDoors:  make(map[string]bool),
+
+		PressedSwitches: make(map[string]bool),
+		BlockSpots:      make(map[string][][2]int),
 	}
 }
 
@@ -63,3 +72,88 @@ func (d *Dungeon) RoomAt(x, y int) *DungeonRoom {
 func (d *Dungeon) CurrentDungeonRoom() *DungeonRoom {
 	return d.Rooms[d.CurrentRoom]
 }
+
+// RestoreState syncs rooms, doors and chests with the per-save maps after
+// progress has been loaded into them.
+func (d *Dungeon) RestoreState() {
+	for pos, room := range d.Rooms {
+		room.Cleared = d.ClearedRooms[pos]
+		for side, door := range room.Doors {
+			if door != nil && d.OpenedDoors[DoorKey(room.X, room.Y, side)] {
+				door.Opened = true
+			}
+		}
+		for i := range room.Chests {
+			c := &room.Chests[i]
+			c.Opened = d.OpenedChests[c.Flag]
+			if c.Opened && (!c.Hidden || c.Switched) {
+				room.Screen.Tiles[c.Y][c.X] = TileChestOpen
+			}
+		}
+		if room.Cleared {
+			room.RevealChests()
+		}
+		room.ApplyDoors()
+
+		for i := range room.Switches {
+			sw := &room.Switches[i]
+			sw.Pressed = d.PressedSwitches[SwitchKey(room.X, room.Y, sw.X, sw.Y)]
+		}
+		room.ApplySwitches()
+		if spots, ok := d.BlockSpots[RoomKey(room.X, room.Y)]; ok {
+			room.MoveBlocks(spots)
+		}
+	}
+}
+
+// RoomKey identifies a room in BlockSpots as "x,y".
+func RoomKey(x, y int) string {
+	return fmt.Sprintf("%d,%d", x, y)
+}
+
+// SwitchKey identifies a floor switch in PressedSwitches as "x,y_sx,sy".
+func SwitchKey(x, y, sx, sy int) string {
+	return fmt.Sprintf("%d,%d_%d,%d", x, y, sx, sy)
+}
+
+// RecordPuzzle copies a room's switches and push blocks into the per-save
+// maps. Switches that need weight on them aren't kept.
+func (d *Dungeon) RecordPuzzle(room *DungeonRoom) {
+	for _, sw := range room.Switches {
+		key := SwitchKey(room.X, room.Y, sw.X, sw.Y)
+		if sw.Pressed && sw.Mode != SwitchHold {
+			d.PressedSwitches[key] = true
+		} else {
+			delete(d.PressedSwitches, key)
+		}
+	}
+	if spots := room.BlockSpots(); len(spots) > 0 {
+		d.BlockSpots[RoomKey(room.X, room.Y)] = spots
+	}
+}
+
+// DoorKey identifies a room door in OpenedDoors as "x,y_side".
+func DoorKey(x, y, side int) string {
+	return fmt.Sprintf("%d,%d_%d", x, y, side)
+}
+
+// OpenDoor opens a room door and the matching door of the room behind it,
+// recording both in OpenedDoors.
+func (d *Dungeon) OpenDoor(x, y, side int) {
+	room := d.RoomAt(x, y)
+	if room == nil || room.Doors[side] == nil {
+		return
+	}
+	room.Doors[side].Opened = true
+	d.OpenedDoors[DoorKey(x, y, side)] = true
+	room.ApplyDoors()
+
+	dx, dy := SideOffset(side)
+	other := d.RoomAt(x+dx, y+dy)
+	opp := OppositeSide(side)
+	if other != nil && other.Doors[opp] != nil && other.Doors[opp].Type == room.Doors[side].Type {
+		other.Doors[opp].Opened = true
+		d.OpenedDoors[DoorKey(other.X, other.Y, opp)] = true
+		other.ApplyDoors()
+	}
+}
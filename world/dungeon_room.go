@@ -1,5 +1,7 @@
 package world
 
+import "github.com/AchrafSoltani/GlowQuest/config"
+
 // DoorType defines how a dungeon room door behaves.
 type DoorType int
 
@@ -9,7 +11,7 @@ const (
 	DoorLocked                   // requires small key
 	DoorBoss                     // requires nightmare key
 	DoorBombable                 // requires bomb to open
-	DoorOneWay                   // one-way passage
+	DoorOneWay                   // one-way passage: the room can be entered through it but not left
 	DoorKeyBlock                 // key block
 	DoorEntrance                 // dungeon entrance/exit
 )
@@ -22,8 +24,46 @@ const (
 	ClearKillAll                       // kill all enemies
 	ClearPuzzle                        // solve a puzzle
 	ClearBoss                          // defeat the boss
+	ClearSwitch                        // switch a named target on
+)
+
+// SwitchMode defines how a floor switch reacts to being pressed.
+type SwitchMode int
+
+const (
+	SwitchLatch  SwitchMode = iota // stays down once pressed
+	SwitchToggle                   // flips on every press
+	SwitchHold                     // down only while weighed down
 )
 
+// SwitchAction defines what a switch target does while it is switched on.
+type SwitchAction int
+
+const (
+	ActionDoor    SwitchAction = iota // opens a door
+	ActionBarrier                     // lowers a block barrier
+	ActionChest                       // makes a hidden chest appear
+	ActionBridge                      // raises a bridge over a pit or water
+)
+
+// RoomSwitch is a floor switch and the named target it drives.
+type RoomSwitch struct {
+	X       int
+	Y       int
+	Target  string // name of the targets switched on while pressed
+	Mode    SwitchMode
+	Pressed bool
+}
+
+// SwitchTarget is a tile changed while any switch driving its name is
+// pressed.
+type SwitchTarget struct {
+	Name   string
+	X      int
+	Y      int
+	Action SwitchAction
+}
+
 // RoomDoor represents a door on one side of a room.
 type RoomDoor struct {
 	Type   DoorType
@@ -37,6 +77,8 @@ type DungeonChest struct {
 	Contents string // item ID
 	Flag     string // save flag for persistence
 	Opened   bool
+	Hidden   bool // only appears once the room is cleared
+	Switched bool // only appears while a switch target is on
 }
 
 // DungeonRoom represents a single room in a dungeon.
@@ -47,7 +89,15 @@ type DungeonRoom struct {
 	EnemySpawns    []EnemySpawn
 	Chests         []DungeonChest
 	ClearCondition ClearCondition
+	ClearDrop      *ItemSpawn // item dropped when the room is cleared
 	Cleared        bool
+	Sealed         bool // shutters closed until the clear condition is met
+	Switches       []RoomSwitch
+	Targets        []SwitchTarget
+	ClearTarget    string // target that clears the room under ClearSwitch
+
+	targetBase map[[2]int]TileType // original tiles under switched-on targets
+	underBlock map[[2]int]TileType // tiles covered by pushed blocks
 }
 
 // NewDungeonRoom creates an empty dungeon room.
@@ -58,3 +108,283 @@ func NewDungeonRoom(x, y int) *DungeonRoom {
 		Screen: &Screen{},
 	}
 }
+
+// Door sides, used to index DungeonRoom.Doors.
+const (
+	SideNorth = 0
+	SideSouth = 1
+	SideEast  = 2
+	SideWest  = 3
+)
+
+// OppositeSide returns the side of the neighbouring room that shares a doorway.
+func OppositeSide(side int) int {
+	switch side {
+	case SideNorth:
+		return SideSouth
+	case SideSouth:
+		return SideNorth
+	case SideEast:
+		return SideWest
+	default:
+		return SideEast
+	}
+}
+
+// SideOffset returns the grid step taken when leaving a room through a side.
+func SideOffset(side int) (dx, dy int) {
+	switch side {
+	case SideNorth:
+		return 0, -1
+	case SideSouth:
+		return 0, 1
+	case SideEast:
+		return 1, 0
+	default:
+		return -1, 0
+	}
+}
+
+// DoorGapTiles returns the two edge tiles that form the doorway on a side.
+func DoorGapTiles(side int) [2][2]int {
+	cx := config.ScreenGridW/2 - 1
+	cy := config.ScreenGridH/2 - 1
+	switch side {
+	case SideNorth:
+		return [2][2]int{{cx, 0}, {cx + 1, 0}}
+	case SideSouth:
+		return [2][2]int{{cx, config.ScreenGridH - 1}, {cx + 1, config.ScreenGridH - 1}}
+	case SideEast:
+		return [2][2]int{{config.ScreenGridW - 1, cy}, {config.ScreenGridW - 1, cy + 1}}
+	default:
+		return [2][2]int{{0, cy}, {0, cy + 1}}
+	}
+}
+
+// Tile returns the tile drawn in the doorway for the door's current state.
+func (d *RoomDoor) Tile() TileType {
+	if d.Opened {
+		return TileDoorOpen
+	}
+	switch d.Type {
+	case DoorOpen, DoorEntrance:
+		return TileDoorOpen
+	case DoorOneWay:
+		return TileShutter
+	case DoorLocked:
+		return TileDoorLocked
+	case DoorBoss:
+		return TileBossLocked
+	case DoorBombable:
+		return TileCrackedWall
+	case DoorKeyBlock:
+		return TileKeyBlock
+	default:
+		return TileWall
+	}
+}
+
+// NeedsClearing returns true if the room has an unmet clear condition.
+func (r *DungeonRoom) NeedsClearing() bool {
+	return r.ClearCondition != ClearNone && !r.Cleared
+}
+
+// RevealChests places the tiles of hidden chests once the room is cleared.
+func (r *DungeonRoom) RevealChests() {
+	for i := range r.Chests {
+		c := &r.Chests[i]
+		if !c.Hidden || c.Switched {
+			continue
+		}
+		if c.Opened {
+			r.Screen.Tiles[c.Y][c.X] = TileChestOpen
+		} else {
+			r.Screen.Tiles[c.Y][c.X] = TileChest
+		}
+	}
+}
+
+// SideAt returns the side whose doorway contains the tile, or -1.
+func SideAt(tx, ty int) int {
+	for side := SideNorth; side <= SideWest; side++ {
+		for _, t := range DoorGapTiles(side) {
+			if t[0] == tx && t[1] == ty {
+				return side
+			}
+		}
+	}
+	return -1
+}
+
+// ApplyDoors writes each door's tile into the room's doorways.
+// Sides without a door keep the tiles from the map data, and open
+// doorways are shuttered while the room is sealed.
+func (r *DungeonRoom) ApplyDoors() {
+	for side, door := range r.Doors {
+		if door == nil {
+			continue
+		}
+		tile := door.Tile()
+		if r.Sealed && tile == TileDoorOpen {
+			tile = TileShutter
+		}
+		for _, t := range DoorGapTiles(side) {
+			r.Screen.Tiles[t[1]][t[0]] = tile
+		}
+	}
+}
+
+// SwitchAt returns the floor switch at (gx, gy), or nil.
+func (r *DungeonRoom) SwitchAt(gx, gy int) *RoomSwitch {
+	for i := range r.Switches {
+		if r.Switches[i].X == gx && r.Switches[i].Y == gy {
+			return &r.Switches[i]
+		}
+	}
+	return nil
+}
+
+// TargetActive returns true if a pressed switch drives the named target.
+func (r *DungeonRoom) TargetActive(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, sw := range r.Switches {
+		if sw.Pressed && sw.Target == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SwitchesPressed returns true once every floor switch in the room is down.
+func (r *DungeonRoom) SwitchesPressed() bool {
+	for _, sw := range r.Switches {
+		if !sw.Pressed {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplySwitches writes the switch and target tiles for the current switch
+// state. Returns true if a target changed.
+func (r *DungeonRoom) ApplySwitches() bool {
+	for _, sw := range r.Switches {
+		tile := TileSwitchOff
+		if sw.Pressed {
+			tile = TileSwitchOn
+		}
+		// A block resting on the switch hides it
+		if r.Screen.Tiles[sw.Y][sw.X] != TileBlock {
+			r.Screen.Tiles[sw.Y][sw.X] = tile
+		}
+	}
+
+	changed := false
+	for _, t := range r.Targets {
+		pos := [2]int{t.X, t.Y}
+		_, on := r.targetBase[pos]
+		if r.TargetActive(t.Name) {
+			if !on {
+				r.switchOn(t)
+				changed = true
+			}
+		} else if on && r.switchOff(t) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// switchOn changes a target's tile, remembering the original.
+func (r *DungeonRoom) switchOn(t SwitchTarget) {
+	if r.targetBase == nil {
+		r.targetBase = make(map[[2]int]TileType)
+	}
+	r.targetBase[[2]int{t.X, t.Y}] = r.Screen.Tiles[t.Y][t.X]
+
+	switch t.Action {
+	case ActionDoor:
+		r.Screen.Tiles[t.Y][t.X] = TileDoorOpen
+	case ActionBarrier:
+		r.Screen.Tiles[t.Y][t.X] = TileBarrierDown
+	case ActionBridge:
+		r.Screen.Tiles[t.Y][t.X] = TileBridge
+	case ActionChest:
+		for _, c := range r.Chests {
+			if c.X != t.X || c.Y != t.Y {
+				continue
+			}
+			if c.Opened {
+				r.Screen.Tiles[t.Y][t.X] = TileChestOpen
+			} else {
+				r.Screen.Tiles[t.Y][t.X] = TileChest
+			}
+		}
+	}
+}
+
+// switchOff puts back a target's original tile. Chests stay once opened and
+// nothing is restored under a block. Returns false if the tile was kept.
+func (r *DungeonRoom) switchOff(t SwitchTarget) bool {
+	pos := [2]int{t.X, t.Y}
+	tile := r.Screen.Tiles[t.Y][t.X]
+	if tile == TileBlock || tile == TileChestOpen {
+		return false
+	}
+	r.Screen.Tiles[t.Y][t.X] = r.targetBase[pos]
+	delete(r.targetBase, pos)
+	return true
+}
+
+// PlaceBlock sets a pushed block down at (gx, gy), remembering the tile
+// under it.
+func (r *DungeonRoom) PlaceBlock(gx, gy int) {
+	if r.underBlock == nil {
+		r.underBlock = make(map[[2]int]TileType)
+	}
+	r.underBlock[[2]int{gx, gy}] = r.Screen.Tiles[gy][gx]
+	r.Screen.Tiles[gy][gx] = TileBlock
+}
+
+// LiftBlock takes a block off (gx, gy) as it is pushed away, uncovering the
+// tile under it. Blocks from the map data sit on plain floor.
+func (r *DungeonRoom) LiftBlock(gx, gy int) {
+	pos := [2]int{gx, gy}
+	tile, ok := r.underBlock[pos]
+	if !ok {
+		tile = TileFloor
+		if r.SwitchAt(gx, gy) != nil {
+			tile = TileSwitchOff
+		}
+	}
+	delete(r.underBlock, pos)
+	r.Screen.Tiles[gy][gx] = tile
+}
+
+// BlockSpots returns the tiles the room's push blocks rest on.
+func (r *DungeonRoom) BlockSpots() [][2]int {
+	var spots [][2]int
+	for gy := 0; gy < config.ScreenGridH; gy++ {
+		for gx := 0; gx < config.ScreenGridW; gx++ {
+			if r.Screen.Tiles[gy][gx] == TileBlock {
+				spots = append(spots, [2]int{gx, gy})
+			}
+		}
+	}
+	return spots
+}
+
+// MoveBlocks lifts every push block in the room and sets them down on the
+// given tiles instead.
+func (r *DungeonRoom) MoveBlocks(spots [][2]int) {
+	for _, pos := range r.BlockSpots() {
+		r.LiftBlock(pos[0], pos[1])
+	}
+	for _, pos := range spots {
+		if onGrid(pos[0], pos[1]) {
+			r.PlaceBlock(pos[0], pos[1])
+		}
+	}
+}
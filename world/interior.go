@@ -6,6 +6,7 @@ type DoorLink struct {
 	DoorTileX  int
 	DoorTileY  int
 	InteriorID string
+	DungeonID  string // set instead of InteriorID for "dungeon:ID" warps
 	SpawnX     float64
 	SpawnY     float64
 	ExitX      float64
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/fs"
 	"log"
+	"strings"
 
 	"github.com/AchrafSoltani/GlowQuest/config"
 	"github.com/AchrafSoltani/GlowQuest/data"
@@ -39,6 +40,9 @@ type jsonScreen struct {
 	Items   []jsonItem       `json:"items"`
 	NPCs    []jsonNPC        `json:"npcs"`
 	Warps   []jsonWarp       `json:"warps"`
+	Buried  []jsonItem       `json:"buried,omitempty"` // treasure dug up with the shovel
+	Pits    []jsonPit        `json:"pits,omitempty"`
+	Signs   []jsonSign       `json:"signs,omitempty"`
 }
 
 type jsonEnemy struct {
@@ -52,6 +56,7 @@ type jsonItem struct {
 	X         int         `json:"x"`
 	Y         int         `json:"y"`
 	Condition string      `json:"condition,omitempty"`
+	Hidden    bool        `json:"hidden,omitempty"` // under a rock, pot or bush
 }
 
 type jsonNPC struct {
@@ -63,11 +68,23 @@ type jsonNPC struct {
 	DialogueKey string             `json:"dialogue_key"`
 	Dialogues   []jsonDialogueOpt  `json:"dialogues,omitempty"`
 	Condition   string             `json:"condition,omitempty"`
+	Asleep      bool               `json:"asleep,omitempty"` // woken by the Song of Soul
+	PowderFlag  string             `json:"powder_flag,omitempty"` // set when sprinkled with Magic Powder
 }
 
 type jsonDialogueOpt struct {
 	Key       string `json:"key"`
 	Condition string `json:"condition"`
+	SetFlag   string `json:"set_flag,omitempty"` // quest flag set when these lines are shown
+}
+
+// jsonSign binds text to a signpost or owl statue tile, chosen the same way
+// as an NPC's dialogue.
+type jsonSign struct {
+	X           int               `json:"x"`
+	Y           int               `json:"y"`
+	DialogueKey string            `json:"dialogue_key"`
+	Dialogues   []jsonDialogueOpt `json:"dialogues,omitempty"`
 }
 
 type jsonWarp struct {
@@ -78,6 +95,16 @@ type jsonWarp struct {
 	SY     float64 `json:"sy"`
 	EX     float64 `json:"ex"`
 	EY     float64 `json:"ey"`
+	Hidden bool    `json:"hidden,omitempty"` // under a bush until it is cut
+}
+
+// jsonPit is a pit that drops the player to a lower floor.
+type jsonPit struct {
+	X      int     `json:"x"`
+	Y      int     `json:"y"`
+	Target string  `json:"target"`
+	SX     float64 `json:"sx"`
+	SY     float64 `json:"sy"`
 }
 
 // --- Interior JSON structures ---
@@ -93,6 +120,65 @@ type jsonInterior struct {
 	Items   []jsonItem   `json:"items"`
 	NPCs    []jsonNPC    `json:"npcs"`
 	Warps   []jsonWarp   `json:"warps"`
+	Pits    []jsonPit    `json:"pits,omitempty"`
+	Signs   []jsonSign   `json:"signs,omitempty"`
+}
+
+// --- Dungeon JSON structures ---
+
+type jsonDungeon struct {
+	ID       int                `json:"id"`
+	Name     string             `json:"name"`
+	Width    int                `json:"width"`
+	Height   int                `json:"height"`
+	Entrance struct{ X, Y int } `json:"entrance"`
+	BossRoom struct{ X, Y int } `json:"boss_room"`
+	Rooms    []jsonDungeonRoom  `json:"rooms"`
+}
+
+type jsonDungeonRoom struct {
+	X       int           `json:"x"`
+	Y       int           `json:"y"`
+	Doors   jsonRoomDoors `json:"doors"`
+	Clear   string        `json:"clear,omitempty"`
+	Tiles   [][]int       `json:"tiles"`
+	Enemies []jsonEnemy   `json:"enemies"`
+	Chests  []jsonChest   `json:"chests"`
+	Drop    *jsonItem     `json:"drop,omitempty"`
+	Pits    []jsonPit     `json:"pits,omitempty"`
+	Signs   []jsonSign    `json:"signs,omitempty"`
+
+	Switches []jsonSwitch       `json:"switches,omitempty"`
+	Targets  []jsonSwitchTarget `json:"targets,omitempty"`
+}
+
+type jsonRoomDoors struct {
+	North string `json:"north,omitempty"`
+	South string `json:"south,omitempty"`
+	East  string `json:"east,omitempty"`
+	West  string `json:"west,omitempty"`
+}
+
+type jsonChest struct {
+	X        int    `json:"x"`
+	Y        int    `json:"y"`
+	Contents string `json:"contents"`
+	Flag     string `json:"flag"`
+	Hidden   bool   `json:"hidden,omitempty"`
+}
+
+type jsonSwitch struct {
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	Target string `json:"target"`
+	Mode   string `json:"mode,omitempty"` // "latch" (default), "toggle" or "hold"
+}
+
+type jsonSwitchTarget struct {
+	Name   string `json:"name"`
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	Action string `json:"action"` // "door", "barrier", "chest" or "bridge"
 }
 
 // --- Dialogue table ---
@@ -159,6 +245,21 @@ var DialogueTable = map[string][]string{
 		"someone... Please be",
 		"careful out there.",
 	},
+	"marin_teach_ballad": {
+		"Oh, an ocarina! Let",
+		"me teach you the song",
+		"I always sing...",
+		"You learned the",
+		"Ballad of the Wind",
+		"Fish! Play it to open",
+		"doors that answer to",
+		"music.",
+	},
+	"marin_ballad_known": {
+		"Do you still play the",
+		"Ballad? I hope it",
+		"reaches the Wind Fish.",
+	},
 
 	// Madam MeowMeow
 	"meowmeow_intro": {
@@ -173,6 +274,19 @@ var DialogueTable = map[string][]string{
 		"in the Egg atop the",
 		"mountains...",
 	},
+	"librarian_teach_soul": {
+		"An ocarina! This old",
+		"book has a song for",
+		"it. Listen closely...",
+		"You learned the Song",
+		"of Soul! It wakes the",
+		"sleeping and calms foes.",
+	},
+	"librarian_soul_known": {
+		"Play the Song of Soul",
+		"to wake anyone lost",
+		"in a deep sleep.",
+	},
 
 	// Shop
 	"shopkeeper_hello": {
@@ -197,13 +311,48 @@ var DialogueTable = map[string][]string{
 		"what the sea washed",
 		"ashore...",
 	},
+	"owl_statue_village_d1": {
+		"The Tail Cave lies",
+		"conquered. Seek the",
+		"next instrument...",
+	},
+	"sign_library": {
+		"WEST: LIBRARY",
+		"Knowledge is the",
+		"hero's best weapon.",
+	},
+	"sign_library_read": {
+		"WEST: LIBRARY",
+		"Come back any time!",
+	},
 
 	// Beach
+	"fisherman_awake": {
+		"Huh? I must have",
+		"dozed off... They say",
+		"the sealed door in",
+		"the east cliffs opens",
+		"to Marin's ballad.",
+	},
 	"beach_hermit": {
 		"This shore is called",
 		"Toronbo. Many things",
 		"wash up here...",
 	},
+	"hermit_teach_mambo": {
+		"An ocarina? Then try",
+		"this little dance",
+		"tune of mine!",
+		"You learned Manbo's",
+		"Mambo! Play it to",
+		"return to a warp",
+		"point you've found.",
+	},
+	"hermit_mambo_known": {
+		"Keep dancing! Warp",
+		"points glow where the",
+		"Mambo can take you.",
+	},
 
 	// Old Man (moved to interior)
 	"old_man_cave": {
@@ -211,6 +360,21 @@ var DialogueTable = map[string][]string{
 		"Rest here before you",
 		"venture further.",
 	},
+	"old_man_powdered": {
+		"Achoo! That powder...",
+		"It clears the head!",
+		"I remember now: the",
+		"cave to the north",
+		"hides a secret.",
+	},
+
+	// Tail Cave
+	"owl_tail_cave": {
+		"The Nightmare sleeps",
+		"beyond the north door.",
+		"Its key lies past the",
+		"spikes to the east.",
+	},
 
 	// Phone booth
 	"phone_hint": {
@@ -343,9 +507,17 @@ func convertJSONScreen(js *jsonScreen) *Screen {
 	// Load items
 	for _, ji := range js.Items {
 		s.ItemSpawns = append(s.ItemSpawns, ItemSpawn{
-			Type:  resolveItemType(ji.Type),
-			TileX: ji.X,
-			TileY: ji.Y,
+			Type:   resolveItemType(ji.Type),
+			TileX:  ji.X,
+			TileY:  ji.Y,
+			Hidden: ji.Hidden,
+		})
+	}
+	for _, jb := range js.Buried {
+		s.Buried = append(s.Buried, ItemSpawn{
+			Type:  resolveItemType(jb.Type),
+			TileX: jb.X,
+			TileY: jb.Y,
 		})
 	}
 
@@ -365,8 +537,11 @@ func convertJSONScreen(js *jsonScreen) *Screen {
 			SpawnY: jw.SY,
 			ExitX:  jw.EX,
 			ExitY:  jw.EY,
+			Hidden: jw.Hidden,
 		})
 	}
+	s.Pits = convertJSONPits(js.Pits)
+	s.Signs = convertJSONSigns(js.Signs)
 
 	return s
 }
@@ -394,9 +569,10 @@ func convertJSONInterior(ji *jsonInterior) *InteriorDef {
 
 	for _, jit := range ji.Items {
 		def.ItemSpawns = append(def.ItemSpawns, ItemSpawn{
-			Type:  resolveItemType(jit.Type),
-			TileX: jit.X,
-			TileY: jit.Y,
+			Type:   resolveItemType(jit.Type),
+			TileX:  jit.X,
+			TileY:  jit.Y,
+			Hidden: jit.Hidden,
 		})
 	}
 
@@ -416,6 +592,8 @@ func convertJSONInterior(ji *jsonInterior) *InteriorDef {
 			ExitY:      jw.EY,
 		})
 	}
+	s.Pits = convertJSONPits(ji.Pits)
+	s.Signs = convertJSONSigns(ji.Signs)
 
 	return def
 }
@@ -433,6 +611,8 @@ func convertJSONNPC(jn *jsonNPC) NPCSpawn {
 		Dir:      jn.Dir,
 		Name:     jn.Name,
 		Dialogue: dialogue,
+		Asleep:     jn.Asleep,
+		PowderFlag: jn.PowderFlag,
 	}
 
 	// Build conditional dialogues from "dialogues" array
@@ -444,6 +624,7 @@ func convertJSONNPC(jn *jsonNPC) NPCSpawn {
 		spawn.ConditionalDialogues = append(spawn.ConditionalDialogues, entity.DialogueOption{
 			Condition: d.Condition,
 			Lines:     lines,
+			SetFlag:   d.SetFlag,
 		})
 	}
 
@@ -464,6 +645,8 @@ func resolveEnemyType(v interface{}) int {
 			return 2
 		case "boss":
 			return 3
+		case "ice_octorok":
+			return 17
 		default:
 			return 0
 		}
@@ -487,6 +670,16 @@ func resolveItemType(v interface{}) int {
 			return 3
 		case "heart_container":
 			return 4
+		case "arrows":
+			return 5
+		case "bombs":
+			return 6
+		case "seashell":
+			return 7
+		case "powder":
+			return 8
+		case "fairy":
+			return 9
 		default:
 			return 0
 		}
@@ -501,7 +694,19 @@ func BuildDoorLinksFromScreens(screens map[[2]int]*Screen) []DoorLink {
 	var links []DoorLink
 	for pos, screen := range screens {
 		for _, w := range screen.Warps {
-			if len(w.Target) > 9 && w.Target[:9] == "interior:" {
+			if len(w.Target) > 8 && w.Target[:8] == "dungeon:" {
+				links = append(links, DoorLink{
+					ScreenX:   pos[0],
+					ScreenY:   pos[1],
+					DoorTileX: w.TileX,
+					DoorTileY: w.TileY,
+					DungeonID: w.Target[8:],
+					SpawnX:    w.SpawnX,
+					SpawnY:    w.SpawnY,
+					ExitX:     w.ExitX,
+					ExitY:     w.ExitY,
+				})
+			} else if len(w.Target) > 9 && w.Target[:9] == "interior:" {
 				interiorID := w.Target[9:]
 				links = append(links, DoorLink{
 					ScreenX:    pos[0],
@@ -520,7 +725,9 @@ func BuildDoorLinksFromScreens(screens map[[2]int]*Screen) []DoorLink {
 	return links
 }
 
-// LoadDungeon loads a dungeon definition from JSON. Placeholder for Phase 8+.
+// LoadDungeon loads a dungeon definition from maps/dungeons/<id>.json.
+// Returns nil if the file is missing, malformed, has an id outside 1-9 or
+// has no entrance room.
 func LoadDungeon(id string) *Dungeon {
 	path := fmt.Sprintf("maps/dungeons/%s.json", id)
 	raw, err := data.MapsFS.ReadFile(path)
@@ -528,6 +735,255 @@ func LoadDungeon(id string) *Dungeon {
 		log.Printf("loader: dungeon %s not found: %v", id, err)
 		return nil
 	}
-	_ = raw // TODO: parse dungeon JSON in Phase 8
-	return nil
+	var jd jsonDungeon
+	if err := json.Unmarshal(raw, &jd); err != nil {
+		log.Printf("loader: failed to parse dungeon %s: %v", id, err)
+		return nil
+	}
+	if DungeonID(jd.ID) < DungeonTailCave || DungeonID(jd.ID) > DungeonColour {
+		// Progress is saved per dungeon number, so a bad id would never be saved
+		log.Printf("loader: dungeon %s: id %d is not a dungeon number (1-9)", id, jd.ID)
+		return nil
+	}
+
+	d := NewDungeon(DungeonID(jd.ID), jd.Name, jd.Width, jd.Height)
+	d.MapID = id
+	d.EntrancePos = [2]int{jd.Entrance.X, jd.Entrance.Y}
+	d.BossRoomPos = [2]int{jd.BossRoom.X, jd.BossRoom.Y}
+	d.CurrentRoom = d.EntrancePos
+
+	for _, jr := range jd.Rooms {
+		if jr.X < 0 || jr.Y < 0 || jr.X >= jd.Width || jr.Y >= jd.Height {
+			log.Printf("loader: dungeon %s: room %d,%d is outside the %dx%d grid", id, jr.X, jr.Y, jd.Width, jd.Height)
+			continue
+		}
+		room := convertJSONDungeonRoom(id, &jr)
+		d.Rooms[[2]int{room.X, room.Y}] = room
+	}
+	if d.RoomAt(d.EntrancePos[0], d.EntrancePos[1]) == nil {
+		log.Printf("loader: dungeon %s: no entrance room at %d,%d", id, d.EntrancePos[0], d.EntrancePos[1])
+		return nil
+	}
+	if d.RoomAt(d.BossRoomPos[0], d.BossRoomPos[1]) == nil {
+		log.Printf("loader: dungeon %s: no boss room at %d,%d", id, d.BossRoomPos[0], d.BossRoomPos[1])
+	}
+	return d
+}
+
+func convertJSONDungeonRoom(dungeonID string, jr *jsonDungeonRoom) *DungeonRoom {
+	room := NewDungeonRoom(jr.X, jr.Y)
+
+	for y := 0; y < config.ScreenGridH && y < len(jr.Tiles); y++ {
+		for x := 0; x < config.ScreenGridW && x < len(jr.Tiles[y]); x++ {
+			room.Screen.Tiles[y][x] = TileType(jr.Tiles[y][x])
+		}
+	}
+
+	sides := [4]string{jr.Doors.North, jr.Doors.South, jr.Doors.East, jr.Doors.West}
+	for side, name := range sides {
+		if name == "" {
+			continue
+		}
+		room.Doors[side] = &RoomDoor{Type: resolveDoorType(name)}
+	}
+	room.ApplyDoors()
+
+	room.Screen.Pits = convertJSONPits(jr.Pits)
+	room.Screen.Signs = convertJSONSigns(jr.Signs)
+
+	room.ClearCondition = resolveClearCondition(jr.Clear)
+	if name, ok := strings.CutPrefix(jr.Clear, "switch:"); ok {
+		room.ClearTarget = name
+	}
+	if jr.Drop != nil {
+		room.ClearDrop = &ItemSpawn{
+			Type:  resolveItemType(jr.Drop.Type),
+			TileX: jr.Drop.X,
+			TileY: jr.Drop.Y,
+		}
+	}
+
+	for _, je := range jr.Enemies {
+		room.EnemySpawns = append(room.EnemySpawns, EnemySpawn{
+			Type:  resolveEnemyType(je.Type),
+			TileX: je.X,
+			TileY: je.Y,
+		})
+	}
+
+	for i, jc := range jr.Chests {
+		if !onGrid(jc.X, jc.Y) {
+			log.Printf("loader: dungeon %s room %d,%d: chest %d at %d,%d is off the grid", dungeonID, jr.X, jr.Y, i, jc.X, jc.Y)
+			continue
+		}
+		flag := jc.Flag
+		if flag == "" {
+			flag = fmt.Sprintf("%s_%d,%d_%d", dungeonID, jr.X, jr.Y, i)
+		}
+		room.Chests = append(room.Chests, DungeonChest{
+			X:        jc.X,
+			Y:        jc.Y,
+			Contents: jc.Contents,
+			Flag:     flag,
+			Hidden:   jc.Hidden,
+		})
+		if !jc.Hidden {
+			room.Screen.Tiles[jc.Y][jc.X] = TileChest
+		}
+	}
+
+	convertJSONSwitches(room, jr)
+
+	return room
+}
+
+// convertJSONSwitches sets up a room's floor switches and their targets.
+// Every switch tile becomes a latching switch; the JSON gives them targets
+// and modes.
+func convertJSONSwitches(room *DungeonRoom, jr *jsonDungeonRoom) {
+	for y := 0; y < config.ScreenGridH; y++ {
+		for x := 0; x < config.ScreenGridW; x++ {
+			if room.Screen.Tiles[y][x] == TileSwitchOff {
+				room.Switches = append(room.Switches, RoomSwitch{X: x, Y: y})
+			}
+		}
+	}
+	for _, js := range jr.Switches {
+		sw := room.SwitchAt(js.X, js.Y)
+		if sw == nil {
+			log.Printf("loader: room %d,%d has no switch at %d,%d", jr.X, jr.Y, js.X, js.Y)
+			continue
+		}
+		sw.Target = js.Target
+		sw.Mode = resolveSwitchMode(js.Mode)
+	}
+
+	for _, jt := range jr.Targets {
+		if !onGrid(jt.X, jt.Y) {
+			log.Printf("loader: room %d,%d: target %q at %d,%d is off the grid", jr.X, jr.Y, jt.Name, jt.X, jt.Y)
+			continue
+		}
+		action := resolveSwitchAction(jt.Action)
+		room.Targets = append(room.Targets, SwitchTarget{
+			Name:   jt.Name,
+			X:      jt.X,
+			Y:      jt.Y,
+			Action: action,
+		})
+		if action != ActionChest {
+			continue
+		}
+		for i := range room.Chests {
+			c := &room.Chests[i]
+			if c.X == jt.X && c.Y == jt.Y {
+				c.Hidden = true
+				c.Switched = true
+				room.Screen.Tiles[c.Y][c.X] = TileFloor
+			}
+		}
+	}
+}
+
+// onGrid returns true if (x, y) is a tile inside a screen.
+func onGrid(x, y int) bool {
+	return x >= 0 && x < config.ScreenGridW && y >= 0 && y < config.ScreenGridH
+}
+
+func convertJSONPits(jps []jsonPit) []PitWarp {
+	var pits []PitWarp
+	for _, jp := range jps {
+		pits = append(pits, PitWarp{
+			TileX:  jp.X,
+			TileY:  jp.Y,
+			Target: jp.Target,
+			SpawnX: jp.SX,
+			SpawnY: jp.SY,
+		})
+	}
+	return pits
+}
+
+func convertJSONSigns(jss []jsonSign) []Sign {
+	var signs []Sign
+	for _, js := range jss {
+		text := DialogueTable[js.DialogueKey]
+		if text == nil {
+			log.Printf("loader: sign at %d,%d has no text %q", js.X, js.Y, js.DialogueKey)
+			continue
+		}
+		sign := Sign{TileX: js.X, TileY: js.Y, Text: text}
+		for _, d := range js.Dialogues {
+			lines := DialogueTable[d.Key]
+			if lines == nil {
+				continue
+			}
+			sign.Options = append(sign.Options, entity.DialogueOption{
+				Condition: d.Condition,
+				Lines:     lines,
+				SetFlag:   d.SetFlag,
+			})
+		}
+		signs = append(signs, sign)
+	}
+	return signs
+}
+
+func resolveDoorType(name string) DoorType {
+	switch name {
+	case "open":
+		return DoorOpen
+	case "locked":
+		return DoorLocked
+	case "boss":
+		return DoorBoss
+	case "bombable":
+		return DoorBombable
+	case "one_way":
+		return DoorOneWay
+	case "key_block":
+		return DoorKeyBlock
+	case "entrance":
+		return DoorEntrance
+	default:
+		return DoorNone
+	}
+}
+
+func resolveClearCondition(name string) ClearCondition {
+	switch name {
+	case "kill_all":
+		return ClearKillAll
+	case "puzzle":
+		return ClearPuzzle
+	case "boss":
+		return ClearBoss
+	}
+	if strings.HasPrefix(name, "switch:") {
+		return ClearSwitch
+	}
+	return ClearNone
+}
+
+func resolveSwitchMode(name string) SwitchMode {
+	switch name {
+	case "toggle":
+		return SwitchToggle
+	case "hold":
+		return SwitchHold
+	default:
+		return SwitchLatch
+	}
+}
+
+func resolveSwitchAction(name string) SwitchAction {
+	switch name {
+	case "barrier":
+		return ActionBarrier
+	case "chest":
+		return ActionChest
+	case "bridge":
+		return ActionBridge
+	default:
+		return ActionDoor
+	}
 }
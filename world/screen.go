@@ -14,9 +14,10 @@ type EnemySpawn struct {
 }
 
 type ItemSpawn struct {
-	Type  int // maps to entity.ItemType
-	TileX int
-	TileY int
+	Type   int // maps to entity.ItemType
+	TileX  int
+	TileY  int
+	Hidden bool // revealed when the tile covering it is lifted or destroyed
 }
 
 type NPCSpawn struct {
@@ -27,6 +28,8 @@ type NPCSpawn struct {
 	Name                string
 	Dialogue            []string
 	ConditionalDialogues []entity.DialogueOption
+	Asleep              bool   // asleep until woken by the Song of Soul
+	PowderFlag          string // quest flag set when sprinkled with Magic Powder
 }
 
 // ScreenWarp defines a warp point on a screen (door, stairs, etc.).
@@ -38,6 +41,26 @@ type ScreenWarp struct {
 	SpawnY float64
 	ExitX  float64 // return position when exiting
 	ExitY  float64
+	Hidden bool // stairs covered by a bush until it is cut
+}
+
+// PitWarp drops a player who falls into the pit at (TileX, TileY) to a
+// lower floor instead of back at the room entrance.
+type PitWarp struct {
+	TileX  int
+	TileY  int
+	Target string  // "interior:ID", or "room:X,Y" inside the current dungeon
+	SpawnX float64 // landing position in the target
+	SpawnY float64
+}
+
+// Sign binds readable text to the signpost or owl statue at (TileX, TileY).
+// The first option whose condition holds is read, otherwise Text.
+type Sign struct {
+	TileX   int
+	TileY   int
+	Text    []string
+	Options []entity.DialogueOption
 }
 
 type Screen struct {
@@ -46,6 +69,11 @@ type Screen struct {
 	ItemSpawns  []ItemSpawn
 	NPCSpawns   []NPCSpawn
 	Warps       []ScreenWarp
+	Buried      []ItemSpawn // fixed treasure dug up with the shovel
+	Pits        []PitWarp
+	Signs       []Sign
+
+	changed map[[2]int]TileType // original tiles cut, lifted or dug since the screen was entered
 }
 
 func (s *Screen) LoadFromString(data string) {
@@ -58,6 +86,82 @@ func (s *Screen) LoadFromString(data string) {
 	}
 }
 
+// CutTile cuts the tile at (gx, gy) down to grass, or to stairs if a hidden
+// warp lies under it. The original tile grows back on Regrow.
+func (s *Screen) CutTile(gx, gy int) {
+	s.remember(gx, gy)
+	s.Tiles[gy][gx] = TileGrass
+	for _, w := range s.Warps {
+		if w.Hidden && w.TileX == gx && w.TileY == gy {
+			s.Tiles[gy][gx] = TileStairs
+		}
+	}
+}
+
+// LiftTile takes the rock or pot at (gx, gy) off the ground it stood on. It
+// is back in place on Regrow.
+func (s *Screen) LiftTile(gx, gy int, ground TileType) {
+	s.remember(gx, gy)
+	s.Tiles[gy][gx] = ground
+}
+
+// DigTile digs a hole at (gx, gy). The ground comes back on FillTile or
+// Regrow.
+func (s *Screen) DigTile(gx, gy int) {
+	s.remember(gx, gy)
+	s.Tiles[gy][gx] = TileHole
+}
+
+// FillTile puts back the original tile at (gx, gy) if it was cut or dug.
+func (s *Screen) FillTile(gx, gy int) {
+	pos := [2]int{gx, gy}
+	if t, ok := s.changed[pos]; ok {
+		s.Tiles[gy][gx] = t
+		delete(s.changed, pos)
+	}
+}
+
+// Regrow restores every tile cut, lifted or dug since the screen was last
+// entered.
+func (s *Screen) Regrow() {
+	for pos, t := range s.changed {
+		s.Tiles[pos[1]][pos[0]] = t
+	}
+	s.changed = nil
+}
+
+// remember records the original tile at (gx, gy) before it is first changed.
+func (s *Screen) remember(gx, gy int) {
+	if s.changed == nil {
+		s.changed = make(map[[2]int]TileType)
+	}
+	pos := [2]int{gx, gy}
+	if _, ok := s.changed[pos]; !ok {
+		s.changed[pos] = s.Tiles[gy][gx]
+	}
+}
+
+// SignAt returns the text bound to the sign at (gx, gy), or nil.
+func (s *Screen) SignAt(gx, gy int) *Sign {
+	for i := range s.Signs {
+		if s.Signs[i].TileX == gx && s.Signs[i].TileY == gy {
+			return &s.Signs[i]
+		}
+	}
+	return nil
+}
+
+// PitAt returns the pit warp at (gx, gy), or nil if falling in there just
+// costs health.
+func (s *Screen) PitAt(gx, gy int) *PitWarp {
+	for i := range s.Pits {
+		if s.Pits[i].TileX == gx && s.Pits[i].TileY == gy {
+			return &s.Pits[i]
+		}
+	}
+	return nil
+}
+
 func (s *Screen) TileAt(gx, gy int) TileType {
 	if gx < 0 || gx >= config.ScreenGridW || gy < 0 || gy >= config.ScreenGridH {
 		return TileWall
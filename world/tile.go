@@ -15,10 +15,10 @@ const (
 
 	// Terrain
 	TileShallowWater TileType = 9  // shallow water (needs Flippers)
-	TileCliffN       TileType = 10 // one-way ledge (jump down south)
-	TileCliffS       TileType = 11 // one-way ledge (jump down north)
-	TileCliffE       TileType = 12 // one-way ledge (jump down west)
-	TileCliffW       TileType = 13 // one-way ledge (jump down east)
+	TileCliffN       TileType = 10 // one-way ledge (jump down north)
+	TileCliffS       TileType = 11 // one-way ledge (jump down south)
+	TileCliffE       TileType = 12 // one-way ledge (jump down east)
+	TileCliffW       TileType = 13 // one-way ledge (jump down west)
 	TileBridge       TileType = 14 // bridge over water
 	TilePit          TileType = 15 // pit (fall down, needs Roc's Feather to cross)
 
@@ -60,7 +60,17 @@ const (
 	TileFenceH      TileType = 46 // horizontal fence
 	TileFenceV      TileType = 47 // vertical fence
 
-	TileCount TileType = 48
+	// Dungeon doors
+	TileShutter  TileType = 48 // closed shutter door
+	TileSongDoor TileType = 49 // sealed door opened by the Ballad of the Wind Fish
+	TileHole     TileType = 50 // hole dug with the shovel (fills back in)
+
+	// Switch puzzles
+	TileBlock       TileType = 51 // pushable stone block
+	TileBarrier     TileType = 52 // raised block barrier (solid)
+	TileBarrierDown TileType = 53 // lowered block barrier (passable)
+
+	TileCount TileType = 54
 )
 
 func TileFromChar(c byte) TileType {
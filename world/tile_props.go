@@ -13,6 +13,9 @@ type TileProperties struct {
 	ConveyorDir int     // 0=none, 1=N, 2=S, 3=E, 4=W
 	JumpDown    bool    // one-way ledge
 	JumpDir     int     // direction to jump (0=N,1=S,2=E,3=W)
+	Hookable    bool    // the hookshot latches on and pulls the player over
+	Diggable    bool    // the shovel can dig a hole here
+	Fall        bool    // walking in drops the player through
 }
 
 // TileProps is the global tile property lookup table.
@@ -25,24 +28,26 @@ func init() {
 	}
 
 	// Passable ground tiles
-	TileProps[TileGrass] = TileProperties{Passable: true, SlowFactor: 1.0}
-	TileProps[TileSand] = TileProperties{Passable: true, SlowFactor: 1.0}
+	TileProps[TileGrass] = TileProperties{Passable: true, Diggable: true, SlowFactor: 1.0}
+	TileProps[TileSand] = TileProperties{Passable: true, Diggable: true, SlowFactor: 1.0}
 	TileProps[TileFloor] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TileStairs] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TileDoorOpen] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TileBridge] = TileProperties{Passable: true, SlowFactor: 1.0}
-	TileProps[TileGrassFlower] = TileProperties{Passable: true, SlowFactor: 1.0}
+	TileProps[TileGrassFlower] = TileProperties{Passable: true, Diggable: true, SlowFactor: 1.0}
+	TileProps[TileHole] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TilePathH] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TilePathV] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TileChestOpen] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TileWarpTile] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TileSwitchOff] = TileProperties{Passable: true, SlowFactor: 1.0}
 	TileProps[TileSwitchOn] = TileProperties{Passable: true, SlowFactor: 1.0}
-	TileProps[TileTorchLit] = TileProperties{Passable: false, SlowFactor: 1.0}
-	TileProps[TileTorch] = TileProperties{Passable: false, SlowFactor: 1.0}
+	TileProps[TileBarrierDown] = TileProperties{Passable: true, SlowFactor: 1.0}
+	TileProps[TileTorchLit] = TileProperties{Passable: false, Hookable: true, SlowFactor: 1.0}
+	TileProps[TileTorch] = TileProperties{Passable: false, Hookable: true, SlowFactor: 1.0}
 
 	// Impassable solid tiles (default, already set)
-	// TileWall, TileTree, TileDoorLocked, TileHouseFront, TileRoof, TileWindow, TileFenceH, TileFenceV
+	// TileWall, TileTree, TileDoorLocked, TileHouseFront, TileRoof, TileWindow, TileFenceH, TileFenceV, TileShutter, TileSongDoor, TileBarrier
 
 	// Water tiles — need Flippers
 	TileProps[TileWater] = TileProperties{Swimmable: true, SlowFactor: 0.5}
@@ -53,10 +58,10 @@ func init() {
 	TileProps[TileRock] = TileProperties{Liftable: 1, SlowFactor: 1.0}
 	TileProps[TileHeavyRock] = TileProperties{Liftable: 2, SlowFactor: 1.0}
 	TileProps[TilePot] = TileProperties{Liftable: 1, SlowFactor: 1.0}
-	TileProps[TileSignpost] = TileProperties{SlowFactor: 1.0} // impassable, interactable
-	TileProps[TileChest] = TileProperties{SlowFactor: 1.0}    // impassable until opened
-	TileProps[TileOwlStatue] = TileProperties{SlowFactor: 1.0}
-	TileProps[TileKeyBlock] = TileProperties{SlowFactor: 1.0}
+	TileProps[TileSignpost] = TileProperties{Hookable: true, SlowFactor: 1.0} // impassable, interactable
+	TileProps[TileChest] = TileProperties{Hookable: true, SlowFactor: 1.0}    // impassable until opened
+	TileProps[TileOwlStatue] = TileProperties{Hookable: true, SlowFactor: 1.0}
+	TileProps[TileKeyBlock] = TileProperties{Hookable: true, SlowFactor: 1.0}
 
 	// Dungeon tiles
 	TileProps[TileCrackedWall] = TileProperties{Bombable: true, SlowFactor: 1.0}
@@ -66,10 +71,11 @@ func init() {
 	TileProps[TileConveyorE] = TileProperties{Passable: true, ConveyorDir: 3, SlowFactor: 1.0}
 	TileProps[TileConveyorW] = TileProperties{Passable: true, ConveyorDir: 4, SlowFactor: 1.0}
 	TileProps[TileSpikes] = TileProperties{Passable: true, Damaging: true, SlowFactor: 1.0}
-	TileProps[TileLava] = TileProperties{Damaging: true, SlowFactor: 1.0} // impassable + damaging
+	TileProps[TileLava] = TileProperties{Damaging: true, Fall: true, SlowFactor: 1.0} // player falls in and takes damage
 	TileProps[TileIce] = TileProperties{Passable: true, Slippery: true, SlowFactor: 1.0}
-	TileProps[TileBossLocked] = TileProperties{SlowFactor: 1.0} // impassable until nightmare key
-	TileProps[TilePit] = TileProperties{SlowFactor: 1.0}        // impassable (jump over with Roc's Feather)
+	TileProps[TileBossLocked] = TileProperties{SlowFactor: 1.0}            // impassable until nightmare key
+	TileProps[TilePit] = TileProperties{Fall: true, SlowFactor: 1.0}       // player falls in (jump over with Roc's Feather)
+	TileProps[TileBlock] = TileProperties{Hookable: true, SlowFactor: 1.0} // impassable, pushed aside
 
 	// Cliff ledges — passable only from one direction (jump down)
 	TileProps[TileCliffN] = TileProperties{JumpDown: true, JumpDir: 0, SlowFactor: 1.0}